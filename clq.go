@@ -7,13 +7,18 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/denisa/clq/internal/changelog"
 	"github.com/denisa/clq/internal/config"
+	"github.com/denisa/clq/internal/diagnostic"
 	"github.com/denisa/clq/internal/output"
 	"github.com/denisa/clq/internal/query"
+	"github.com/denisa/clq/internal/rule"
 	"github.com/denisa/clq/internal/validator"
 	"github.com/yuin/goldmark"
+	emoji "github.com/yuin/goldmark-emoji"
 	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/text"
 	"github.com/yuin/goldmark/util"
@@ -26,6 +31,7 @@ type Clq struct {
 	stdout, stderr io.Writer
 	verbose        bool
 	documents      []string
+	diagnostics    diagnostic.Format
 }
 
 func main() {
@@ -40,11 +46,30 @@ func (clq *Clq) entryPoint(name string, arguments ...string) int {
 		_, _ = fmt.Fprintf(options.Output(), "\nUsage: %s { flags } <path to changelog.md>\n\nOptions are:\n", options.Name())
 		options.PrintDefaults()
 	}
+	var allErrors = options.Bool("all-errors", false, "Report all validation errors instead of stopping at the first one")
+	var branch = options.String("branch", "", "Name of the branch, selecting the restrictions of the branches section of the configuration file")
 	var changeMap = options.String("changeMap", "", "Name of a file defining the mapping from change kind to semantic version change")
+	var configFile = options.String("config", "", "Name of a configuration file, whose rules section sets the severity of the validation rules")
+	var diagnosticsName = options.String("diagnostics", "", "Diagnostics format for validation errors. One of: checkstyle|github|json|junit|sarif")
+	var duplicatesAcrossReleases = options.Bool("duplicates-across-releases", false, "Report duplicate change descriptions across all the releases instead of within each release")
+	var maxIncrement = options.String("max-increment", "", "Largest version increment the changes of the first release may require. One of: major|minor|patch")
+	var maxReleaseAge = options.Int("max-release-age", -1, "Maximum age in days of the most recent release in release mode, 0 to require today's date; negative to disable")
+	var emojiName = options.String("emoji", "", "Whether the change headings start with the emoji of their change kind. One of: optional|required|forbidden (default optional)")
+	var expectDate = options.String("expect-date", "", "Date, as YYYY-MM-DD, expected of the most recent release")
+	var expectVersion = options.String("expect-version", "", "Version expected of the most recent release; a tag name like v1.4.0 is accepted")
+	var forbidChanges = options.String("forbid-changes", "", "Comma-separated list of the change kinds forbidden in the first release")
+	var groupScopes = options.String("group-scopes", "", "Comma-separated list of the scopes accepted as level-4 group headings; implies -groups")
+	var groups = options.Bool("groups", false, "Accept level-4 headings grouping the change descriptions of a change by scope")
 	var formatName = options.String("output", "json", "Output format, for complex result. One of: json|md")
 	var queryString = options.String("query", "", "A query to extract information out of the change log")
 	var release = options.Bool("release", false, "Enable release-mode validation")
+	var rules = options.String("rules", "", "Comma-separated list of rule=severity, where rule is an identifier or a name and severity one of: off|warning|error")
+	var sectionTitles = options.String("sections", "", "Comma-separated list of the titles of the level 2 headings that are sections rather than releases, an exact title or a regular expression between slashes")
+	var strict = options.Bool("strict", false, "Reject the content of the releases other than lists of change descriptions")
+	var strictAllow = options.String("strict-allow", "", "Comma-separated list of content accepted in strict mode, as [release:|change:]kind where kind is one of: blockquote|code|html|list|paragraph|thematic-break")
+	var todayDate = options.String("today", "", "Reference date, as YYYY-MM-DD, against which the release dates are checked (default: the current date)")
 	var showVersion = options.Bool("version", false, "Prints clq version")
+	var warningsAsErrors = options.Bool("warnings-as-errors", false, "Fail the validation on warnings")
 	options.BoolVar(&clq.verbose, "with-filename", false, "Always print filename headers with output lines")
 
 	if options.Parse(arguments) != nil {
@@ -68,15 +93,130 @@ func (clq *Clq) entryPoint(name string, arguments ...string) int {
 		return 2
 	}
 
-	var hasError bool
+	var today time.Time
+	if *todayDate != "" {
+		if today, err = time.Parse("2006-01-02", *todayDate); err != nil {
+			clq.error("", fmt.Errorf("%q is not a valid date, expected YYYY-MM-DD", *todayDate))
+			return 2
+		}
+	}
+
+	var expectedVersion string
+	if *expectVersion != "" {
+		if expectedVersion, err = changelog.NormalizeVersion(*expectVersion); err != nil {
+			clq.error("", fmt.Errorf("%q is not a valid version: %w", *expectVersion, err))
+			return 2
+		}
+	}
+	if *expectDate != "" {
+		if _, err = time.Parse("2006-01-02", *expectDate); err != nil {
+			clq.error("", fmt.Errorf("%q is not a valid date, expected YYYY-MM-DD", *expectDate))
+			return 2
+		}
+	}
+
+	ruleSettings := make(rule.Settings)
+	allowedContent := make(config.ContentAllowList)
+	var descriptionRules changelog.DescriptionRules
+	var kindDescriptionRules map[string]changelog.DescriptionRules
+	var scopes []string
+	var sections config.SectionTitles
+	var changePolicy config.ChangePolicy
+	if *configFile != "" {
+		file, err := config.LoadFile(*configFile)
+		if err != nil {
+			clq.error("", err)
+			return 2
+		}
+		if err := file.ApplyRules(ruleSettings); err != nil {
+			clq.error("", err)
+			return 2
+		}
+		if err := file.ApplyAllow(allowedContent); err != nil {
+			clq.error("", err)
+			return 2
+		}
+		if descriptionRules, kindDescriptionRules, err = file.DescriptionRules(changeKind); err != nil {
+			clq.error("", err)
+			return 2
+		}
+		if err := file.ApplySections(&sections); err != nil {
+			clq.error("", err)
+			return 2
+		}
+		if err := file.ApplyBranches(*branch, &changePolicy); err != nil {
+			clq.error("", err)
+			return 2
+		}
+		if file.Emoji != "" && *emojiName == "" {
+			*emojiName = file.Emoji
+		}
+		if file.Groups != nil {
+			*groups = true
+			scopes = append(scopes, file.Groups.Scopes...)
+		}
+	}
+	if *groupScopes != "" {
+		*groups = true
+		for _, scope := range strings.Split(*groupScopes, ",") {
+			scopes = append(scopes, strings.TrimSpace(scope))
+		}
+	}
+	if err := ruleSettings.Parse(*rules); err != nil {
+		clq.error("", err)
+		return 2
+	}
+	if err := allowedContent.Parse(*strictAllow); err != nil {
+		clq.error("", err)
+		return 2
+	}
+	if err := sections.Parse(*sectionTitles); err != nil {
+		clq.error("", err)
+		return 2
+	}
+	if *maxIncrement != "" {
+		if err := changePolicy.SetMaxIncrement(*maxIncrement); err != nil {
+			clq.error("", err)
+			return 2
+		}
+	}
+	if err := changePolicy.Parse(*forbidChanges); err != nil {
+		clq.error("", err)
+		return 2
+	}
+	if err := changePolicy.Check(changeKind); err != nil {
+		clq.error("", err)
+		return 2
+	}
+
+	emojiPolicy := config.EmojiOptional
+	if *emojiName != "" {
+		if emojiPolicy, err = config.NewEmojiPolicy(*emojiName); err != nil {
+			clq.error("", err)
+			return 2
+		}
+	}
+
+	if *diagnosticsName != "" {
+		clq.diagnostics, err = diagnostic.NewFormat(*diagnosticsName)
+		if err != nil {
+			clq.error("", err)
+			return 2
+		}
+	}
+
+	var hasError, hasWarning bool
 	for _, document := range clq.documents {
+		if clq.diagnostics != nil {
+			clq.diagnostics.Document(displayName(document))
+		}
 		outputFormat, err := output.NewFormat(*formatName)
 		if err != nil {
 			clq.error("", err)
 			return 2
 		}
 
-		queryEngine, err := query.NewEngine(*queryString, outputFormat)
+		queryEngine, err := query.NewEngine(*queryString, changeKind, outputFormat)
 		if err != nil {
 			clq.error("", err)
 			return 2
@@ -89,9 +229,26 @@ func (clq *Clq) entryPoint(name string, arguments ...string) int {
 		}
 
 		reader := text.NewReader(source)
-		doc := goldmark.DefaultParser().Parse(reader)
+		doc := goldmark.New(goldmark.WithExtensions(emoji.Emoji)).Parser().Parse(reader)
 
-		validatorOpts := []config.Option{config.WithRelease(*release), config.WithChangeKind(changeKind)}
+		validatorOpts := []config.Option{
+			config.WithRelease(*release),
+			config.WithAllErrors(*allErrors),
+			config.WithWarningsAsErrors(*warningsAsErrors),
+			config.WithChangeKind(changeKind),
+			config.WithRules(ruleSettings),
+			config.WithToday(today),
+			config.WithMaxReleaseAge(*maxReleaseAge),
+			config.WithExpectedVersion(expectedVersion),
+			config.WithExpectedDate(*expectDate),
+			config.WithDuplicatesAcrossReleases(*duplicatesAcrossReleases),
+			config.WithStrict(*strict, allowedContent),
+			config.WithDescriptionRules(descriptionRules, kindDescriptionRules),
+			config.WithGroups(*groups, scopes),
+			config.WithSections(sections),
+			config.WithEmoji(emojiPolicy),
+			config.WithChangePolicy(changePolicy),
+		}
 		if queryEngine.HasQuery() {
 			validatorOpts = append(validatorOpts, config.WithListener(queryEngine))
 		}
@@ -103,17 +260,37 @@ func (clq *Clq) entryPoint(name string, arguments ...string) int {
 
 		var buf bytes.Buffer
 		if err := validationEngine.Render(&buf, source, doc); err != nil {
+			clq.error(document, err)
+			if isFailure(err) {
+				hasError = true
+				continue
+			}
+			hasWarning = true
+		}
+		result, err := queryEngine.Result()
+		if err != nil {
 			clq.error(document, err)
 			hasError = true
 			continue
 		}
-		clq.output(document, queryEngine.Result())
+		clq.output(document, result)
 	}
 
-	if hasError {
+	if clq.diagnostics != nil {
+		if err := clq.diagnostics.Render(clq.stderr); err != nil {
+			clq.error("", err)
+			return 2
+		}
+	}
+
+	switch {
+	case hasError:
 		return 1
+	case hasWarning:
+		return 3
+	default:
+		return 0
 	}
-	return 0
 }
 
 func (clq *Clq) readInput(input string) ([]byte, error) {
@@ -128,12 +305,33 @@ func (clq *Clq) withFileName() bool {
 }
 
 func (clq *Clq) error(document string, err error) {
+	if joinErr, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joinErr.Unwrap() {
+			clq.error(document, err)
+		}
+		return
+	}
+
+	if clq.diagnostics != nil && document != "" {
+		clq.diagnostics.Add(newDiagnostic(document, err))
+		return
+	}
+
 	var pathErr *os.PathError
 	if errors.As(err, &pathErr) {
 		_, _ = fmt.Fprintf(clq.stderr, "❗️ %v: %v\n", pathErr.Path, pathErr.Err.Error())
 		return
 	}
 
+	var validationErr validator.Error
+	if errors.As(err, &validationErr) && validationErr.Position.IsValid() {
+		_, _ = fmt.Fprintf(clq.stderr, "%v:%v: %v%v\n", displayName(document), validationErr.Position, severityLabel(validationErr.Severity), validationErr.Err)
+		if related := validationErr.Related; related.Position.IsValid() {
+			_, _ = fmt.Fprintf(clq.stderr, "%v:%v: note: %v\n", displayName(document), related.Position, related.Message)
+		}
+		return
+	}
+
 	if clq.withFileName() && document != "" {
 		_, _ = fmt.Fprintf(clq.stderr, "❗️ %v: %v\n", document, err)
 	} else {
@@ -141,6 +339,64 @@ func (clq *Clq) error(document string, err error) {
 	}
 }
 
+// isFailure returns true unless all the errors are warnings.
+func isFailure(err error) bool {
+	if joinErr, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joinErr.Unwrap() {
+			if isFailure(err) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var validationErr validator.Error
+	return !errors.As(err, &validationErr) || validationErr.Severity == rule.Error
+}
+
+// severityLabel returns the label following the position of a warning, like `warning: `,
+// leaving the errors in the plain `FILE:LINE:COL: message` form.
+func severityLabel(severity rule.Severity) string {
+	if severity == rule.Warning {
+		return "warning: "
+	}
+	return ""
+}
+
+// newDiagnostic converts a document error for the diagnostics format.
+func newDiagnostic(document string, err error) diagnostic.Diagnostic {
+	d := diagnostic.Diagnostic{File: displayName(document), Severity: rule.Error, Message: err.Error()}
+
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		d.File = pathErr.Path
+		d.Message = pathErr.Err.Error()
+	}
+
+	var validationErr validator.Error
+	if errors.As(err, &validationErr) {
+		d.Line = validationErr.Position.Line
+		d.Column = validationErr.Position.Column
+		d.Rule = validationErr.Rule
+		d.Severity = validationErr.Severity
+		d.Message = validationErr.Err.Error()
+		if related := validationErr.Related; related.Position.IsValid() {
+			d.RelatedLine = related.Position.Line
+			d.RelatedColumn = related.Position.Column
+			d.RelatedMessage = related.Message
+		}
+	}
+	return d
+}
+
+// displayName returns the name under which the document is reported.
+func displayName(document string) string {
+	if document == "-" {
+		return "<stdin>"
+	}
+	return document
+}
+
 func (clq *Clq) output(document string, result string) {
 	switch {
 	case result == "":
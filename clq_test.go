@@ -113,7 +113,15 @@ func (s Scenario) executeClq(assertions *assert.Assertions) {
 	default:
 		assertions.Equal(s.Output, actualOutput.String())
 	}
-	assertions.Equal(s.Error, actualErr.String())
+	assertions.Equal(s.Error, s.slashed(actualErr.String()))
+}
+
+// slashed returns the text naming the file of the scenario with slashes, as in the scenarios, whatever the platform.
+func (s Scenario) slashed(text string) string {
+	if s.Name == "" || s.Name == "-" {
+		return text
+	}
+	return strings.ReplaceAll(text, filepath.Join("testdata", s.Name), "testdata/"+s.Name)
 }
 
 func allTestFiles(t *testing.T) map[string]bool {
@@ -5,19 +5,41 @@ import "fmt"
 // Change is a level 3 heaading indicating a change kind
 type Change struct {
 	heading
-	emoji string
+	emoji      string
+	hasEmoji   bool
+	changeKind *ChangeKind
 }
 
-func (h HeadingsFactory) newChange(title string) (Heading, error) {
+func (h HeadingsFactory) newChange(title string, position Position) (Heading, error) {
 	if title == "" {
 		return nil, fmt.Errorf("validation error: change cannot stay empty")
 	}
 
-	emoji, err := h.changeKind.emojiFor(title)
+	kind, hasEmoji := h.changeKind.kindOf(title)
+	emoji, err := h.changeKind.emojiFor(kind)
 	if err != nil {
 		return nil, err
 	}
-	return Change{heading{title: title, kind: ChangeHeading}, emoji}, nil
+	return Change{heading{title: kind, kind: ChangeHeading, position: position}, emoji, hasEmoji, h.changeKind}, nil
+}
+
+// Emoji returns the emoji of the change kind, if any.
+func (h Change) Emoji() string {
+	return h.emoji
+}
+
+// HasEmoji returns true if the heading starts with the emoji of its change kind in the changelog.
+func (h Change) HasEmoji() bool {
+	return h.hasEmoji
+}
+
+// IsNamed returns true if the name designates the change kind of the heading: its name or one of its aliases,
+// with or without its emoji.
+func (h Change) IsNamed(name string) bool {
+	if h.changeKind == nil {
+		return name == h.title || name == h.DisplayTitle()
+	}
+	return h.changeKind.aliasOf(name) == h.title
 }
 
 func (h Change) DisplayTitle() string {
@@ -35,6 +57,10 @@ func (h Change) Kind() HeadingKind {
 	return h.kind
 }
 
+func (h Change) Position() Position {
+	return h.position
+}
+
 func (h Change) String() string {
 	return asPath(h.title)
 }
@@ -9,28 +9,66 @@ import (
 func TestNewHeadingChange(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.NewHeading(ChangeHeading, "Security")
+	h, _ := hf.NewHeading(ChangeHeading, "Security", Position{})
 	requireHeadingInterface(t, "Security", h)
 }
 
 func TestChange(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newChange("Security")
+	h, _ := hf.newChange("Security", Position{})
 	requireHeadingInterface(t, "Security", h)
 }
 
 func TestEmptyChangeShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newChange("")
+	_, err := hf.newChange("", Position{})
 	require.Error(t, err)
 }
 
 func TestChangeDisplayTitleWithEmoji(t *testing.T) {
 	ck, _ := NewChangeKind("testdata/patch_only_with_emojis.json")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newChange("Security")
+	h, _ := hf.newChange("Security", Position{})
 	require.Equal(t, "Security", h.Title())
 	require.Equal(t, "🔒 Security", h.DisplayTitle())
 }
+
+func TestChangeWithEmoji(t *testing.T) {
+	ck, _ := NewChangeKind("testdata/patch_only_with_emojis.json")
+	hf := NewHeadingFactory(ck)
+	for _, title := range []string{"🔒 Security", "🔒  Security", "🔒\uFE0F Security"} {
+		h, err := hf.newChange(title, Position{})
+		require.NoError(t, err, title)
+		require.Equal(t, "Security", h.Title())
+		require.Equal(t, "🔒 Security", h.DisplayTitle())
+		require.True(t, h.(Change).HasEmoji())
+	}
+}
+
+func TestChangeWithoutEmoji(t *testing.T) {
+	ck, _ := NewChangeKind("testdata/patch_only_with_emojis.json")
+	hf := NewHeadingFactory(ck)
+	h, _ := hf.newChange("Security", Position{})
+	require.Equal(t, "🔒", h.(Change).Emoji())
+	require.False(t, h.(Change).HasEmoji())
+}
+
+func TestChangeWithWrongEmojiShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("testdata/patch_only_with_emojis.json")
+	hf := NewHeadingFactory(ck)
+	_, err := hf.newChange("🐛 Security", Position{})
+	require.Error(t, err)
+}
+
+func TestChangeIsNamed(t *testing.T) {
+	ck, _ := NewChangeKind("testdata/patch_only_with_emojis.json")
+	hf := NewHeadingFactory(ck)
+	h, _ := hf.newChange("🔒 Security", Position{})
+	change := h.(Change)
+	require.True(t, change.IsNamed("Security"))
+	require.True(t, change.IsNamed("🔒 Security"))
+	require.False(t, change.IsNamed("Fixed"))
+	require.False(t, change.IsNamed("Unknown"))
+}
@@ -2,16 +2,44 @@ package changelog
 
 import "fmt"
 
-// ChangeItem is a list item, a single change under a Change heading
+// ChangeItem is a list item, a single change under a Change heading, with its nested list items
 type ChangeItem struct {
 	heading
+	markdown string
+	items    []ChangeItem
+	group    string
 }
 
-func (h HeadingsFactory) newChangeItem(title string) (Heading, error) {
+func (h HeadingsFactory) newChangeItem(title string, position Position) (Heading, error) {
+	return h.NewChangeItem(title, position, "", nil)
+}
+
+// NewChangeItem returns the change description with the given title, position, markdown as written in the source
+// and nested change descriptions.
+func (h HeadingsFactory) NewChangeItem(title string, position Position, markdown string, items []ChangeItem) (ChangeItem, error) {
 	if title == "" {
-		return nil, fmt.Errorf("validation error: change description cannot stay empty")
+		return ChangeItem{}, fmt.Errorf("validation error: change description cannot stay empty")
+	}
+	return ChangeItem{heading: heading{title: title, kind: ChangeDescription, position: position}, markdown: markdown, items: items}, nil
+}
+
+// Markdown returns the markdown of the change description as written in the source, without its list marker
+// and its nested change descriptions, or its title if the source is unknown.
+func (h ChangeItem) Markdown() string {
+	if h.markdown == "" {
+		return h.title
 	}
-	return ChangeItem{heading{title: title, kind: ChangeDescription}}, nil
+	return h.markdown
+}
+
+// Items returns the nested change descriptions, like the detail bullets following a headline bullet.
+func (h ChangeItem) Items() []ChangeItem {
+	return h.items
+}
+
+// Group returns the title of the group of the change description, or an empty string if it is not in a group.
+func (h ChangeItem) Group() string {
+	return h.group
 }
 
 func (h ChangeItem) DisplayTitle() string {
@@ -26,6 +54,10 @@ func (h ChangeItem) Kind() HeadingKind {
 	return h.kind
 }
 
+func (h ChangeItem) Position() Position {
+	return h.position
+}
+
 func (h ChangeItem) String() string {
 	return asPath(h.title)
 }
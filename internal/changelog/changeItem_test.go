@@ -2,18 +2,50 @@ package changelog
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewHeadingChangeDescription(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.NewHeading(ChangeDescription, "foo")
+	h, _ := hf.NewHeading(ChangeDescription, "foo", Position{})
 	requireHeadingInterface(t, "foo", h)
 }
 
 func TestChangeDescription(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newChangeItem("foo")
+	h, _ := hf.newChangeItem("foo", Position{})
 	requireHeadingInterface(t, "foo", h)
 }
+
+func TestChangeDescriptionWithItems(t *testing.T) {
+	assertions := require.New(t)
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	detail, err := hf.NewChangeItem("bar", Position{Line: 2, Column: 5}, "", nil)
+	assertions.NoError(err)
+	h, err := hf.NewChangeItem("foo", Position{Line: 1, Column: 3}, "", []ChangeItem{detail})
+	assertions.NoError(err)
+	requireHeadingInterface(t, "foo", h)
+	assertions.Equal([]ChangeItem{detail}, h.Items())
+	assertions.Empty(detail.Items())
+}
+
+func TestChangeDescriptionEmpty(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	_, err := hf.NewChangeItem("", Position{}, "", nil)
+	require.Error(t, err)
+}
+
+func TestChangeDescriptionMarkdown(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	h, _ := hf.NewChangeItem("The -output option", Position{}, "The `-output` option", nil)
+	require.Equal(t, "The -output option", h.Title())
+	require.Equal(t, "The `-output` option", h.Markdown())
+	h, _ = hf.NewChangeItem("foo", Position{}, "", nil)
+	require.Equal(t, "foo", h.Markdown())
+}
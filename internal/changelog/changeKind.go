@@ -11,20 +11,27 @@ import (
 )
 
 type config struct {
-	semver semver.Identifier
-	emoji  string
+	semver       semver.Identifier
+	emoji        string
+	aliases      []string
+	descriptions *DescriptionRules
+	order        int
 }
 type changeKindToConfig map[string]config
 
+// keepAChangelogOrder is the order of the change kinds defined by Keep a Changelog.
+var keepAChangelogOrder = map[string]int{"Added": 1, "Changed": 2, "Deprecated": 3, "Removed": 4, "Fixed": 5, "Security": 6}
+
 // ChangeKind collects information about the supported change headers
 type ChangeKind struct {
 	changes changeKindToConfig
+	ordered bool
 }
 
 // NewChangeKind loads a new ChangeKind from a file
 func NewChangeKind(fileName string) (*ChangeKind, error) {
 	if fileName == "" {
-		return &ChangeKind{changes: changeKindToConfig{"Added": {semver.Major, ""}, "Removed": {semver.Major, ""}, "Changed": {semver.Minor, ""}, "Deprecated": {semver.Minor, ""}, "Fixed": {semver.Patch, ""}, "Security": {semver.Patch, ""}}}, nil
+		return &ChangeKind{changes: changeKindToConfig{"Added": {semver.Major, "", nil, nil, 0}, "Removed": {semver.Major, "", nil, nil, 0}, "Changed": {semver.Minor, "", nil, nil, 0}, "Deprecated": {semver.Minor, "", nil, nil, 0}, "Fixed": {semver.Patch, "", nil, nil, 0}, "Security": {semver.Patch, "", nil, nil, 0}}}, nil
 	}
 
 	file, e := os.ReadFile(fileName)
@@ -52,12 +59,67 @@ func (ck *ChangeKind) IncrementFor(c ChangeMap) (semver.Identifier, string) {
 	return increment, trigger
 }
 
-func (ck *ChangeKind) add(name string, increment semver.Identifier, emoji string) error {
+// Has returns true if the title is one of the change kinds.
+func (ck *ChangeKind) Has(title string) bool {
+	_, ok := ck.changes[title]
+	return ok
+}
+
+// Designates returns true if the name designates one of the change kinds, by its name or one of its aliases,
+// with or without its emoji.
+func (ck *ChangeKind) Designates(name string) bool {
+	return ck.Has(ck.aliasOf(name))
+}
+
+// DescriptionRules returns the rules constraining the change descriptions of the change kind, if any.
+func (ck *ChangeKind) DescriptionRules(title string) (DescriptionRules, bool) {
+	if c, ok := ck.changes[title]; ok && c.descriptions != nil {
+		return *c.descriptions, true
+	}
+	return DescriptionRules{}, false
+}
+
+// Order returns the position of the change kind among the change headings of a release, if it has one.
+// Unless some change kind defines its order, the change kinds defined by Keep a Changelog keep their order.
+func (ck *ChangeKind) Order(title string) (int, bool) {
+	c, ok := ck.changes[title]
+	switch {
+	case !ok:
+		return 0, false
+	case ck.ordered:
+		return c.order, c.order > 0
+	default:
+		order, ok := keepAChangelogOrder[title]
+		return order, ok
+	}
+}
+
+// IsOrdered returns true if some change kind of the change map defines its order.
+func (ck *ChangeKind) IsOrdered() bool {
+	return ck.ordered
+}
+
+func (ck *ChangeKind) add(name string, increment semver.Identifier, emoji string, aliases []string, descriptions *DescriptionRules, order int) error {
 	if strings.TrimSpace(name) == "" {
 		return fmt.Errorf("validation error: \"name\" is blank")
 	}
+	if order < 0 {
+		return fmt.Errorf("validation error: %q has a negative order", name)
+	}
+	if kind, ok := ck.aliased(name); ok && kind != name {
+		return fmt.Errorf("validation error: %q is already an alias of %q", name, kind)
+	}
+	for _, alias := range aliases {
+		if strings.TrimSpace(alias) == "" || alias == name {
+			return fmt.Errorf("validation error: %q has an invalid alias %q", name, alias)
+		}
+		if kind, ok := ck.aliased(alias); ok {
+			return fmt.Errorf("validation error: alias %q of %q already names %q", alias, name, kind)
+		}
+	}
 
-	ck.changes[name] = config{semver: increment, emoji: emoji}
+	ck.changes[name] = config{semver: increment, emoji: emoji, aliases: aliases, descriptions: descriptions, order: order}
+	ck.ordered = ck.ordered || order > 0
 	return nil
 }
 
@@ -81,6 +143,66 @@ func (ck *ChangeKind) keysFor(kind semver.Identifier) []string {
 	return result
 }
 
+// kindOf returns the change kind named by a change heading title, and true if the title starts with the emoji
+// of the change kind, like "✨ Added". The emoji variation selectors are not significant.
+// The change headings must use the name of the change kind, never one of its aliases.
+func (ck *ChangeKind) kindOf(title string) (string, bool) {
+	return ck.lookup(title, ck.named)
+}
+
+// aliasOf returns the change kind designated by a name in a query, its name or one of its aliases,
+// with or without its emoji, like "🐛 Bug Fixes".
+func (ck *ChangeKind) aliasOf(name string) string {
+	kind, _ := ck.lookup(name, ck.aliased)
+	return kind
+}
+
+// lookup returns the change kind the names find for the title, with or without the emoji of the change kind,
+// and true if the title starts with that emoji.
+func (ck *ChangeKind) lookup(title string, names func(string) (string, bool)) (string, bool) {
+	if kind, ok := names(title); ok {
+		return kind, false
+	}
+	for name, c := range ck.changes {
+		if c.emoji == "" {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(withoutVariationSelector(title), withoutVariationSelector(c.emoji)); ok {
+			if kind, ok := names(strings.TrimSpace(rest)); ok && kind == name {
+				return name, true
+			}
+		}
+	}
+	return title, false
+}
+
+// named returns the change kind whose name is the title.
+func (ck *ChangeKind) named(title string) (string, bool) {
+	_, ok := ck.changes[title]
+	return title, ok
+}
+
+// aliased returns the change kind whose name, or one of its aliases, is the title.
+func (ck *ChangeKind) aliased(title string) (string, bool) {
+	if _, ok := ck.changes[title]; ok {
+		return title, true
+	}
+	for name, c := range ck.changes {
+		for _, alias := range c.aliases {
+			if alias == title {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// withoutVariationSelector returns the text without the emoji presentation selector U+FE0F,
+// which is optional after most emoji.
+func withoutVariationSelector(text string) string {
+	return strings.ReplaceAll(text, "\uFE0F", "")
+}
+
 func (ck *ChangeKind) emojiFor(title string) (string, error) {
 	if c, ok := ck.changes[title]; ok {
 		return c.emoji, nil
@@ -12,6 +12,12 @@ type ChangeKindDto struct {
 	Name      string `json:"name"`
 	Increment string `json:"increment"`
 	Emoji     string `json:"emoji,omitempty"`
+	// Aliases are the other names of the change kind, accepted in the queries, never in the change headings.
+	Aliases []string `json:"aliases,omitempty"`
+	// Descriptions constrains the change descriptions of the change kind.
+	Descriptions *DescriptionRules `json:"descriptions,omitempty"`
+	// Order is the position of the change kind among the change headings of a release.
+	Order int `json:"order,omitempty"`
 }
 type ChangeKindsDto []*ChangeKindDto
 
@@ -25,7 +31,7 @@ func (s ByName) Less(i, j int) bool { return s.ChangeKindsDto[i].Name < s.Change
 func (ck *ChangeKind) MarshalJSON() ([]byte, error) {
 	var result ChangeKindsDto
 	for k, l := range ck.changes {
-		result = append(result, &ChangeKindDto{Name: k, Increment: l.semver.String(), Emoji: l.emoji})
+		result = append(result, &ChangeKindDto{Name: k, Increment: l.semver.String(), Emoji: l.emoji, Aliases: l.aliases, Descriptions: l.descriptions, Order: l.order})
 	}
 	// enforcing arbitrary order for testing
 	sort.Sort(ByName{result})
@@ -42,7 +48,7 @@ func (ck *ChangeKind) UnmarshalJSON(data []byte) error {
 		if err != nil {
 			return fmt.Errorf("error parsing  %q: %q", val.Name, err)
 		}
-		if err := ck.add(val.Name, inc, val.Emoji); err != nil {
+		if err := ck.add(val.Name, inc, val.Emoji, val.Aliases, val.Descriptions, val.Order); err != nil {
 			return err
 		}
 	}
@@ -45,3 +45,33 @@ func TestUnmarshalIllegalArgument(t *testing.T) {
 		]`), c)
 	require.Error(t, err)
 }
+
+func TestUnmarshalDescriptionRules(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	err := json.Unmarshal([]byte(`[
+		{"name":"Fixed", "increment":"patch"},
+		{"name":"Security", "increment":"patch", "descriptions": {"require": "CVE-\\d{4}-\\d+"}}
+		]`), c)
+	require.NoError(t, err)
+	_, ok := c.DescriptionRules("Fixed")
+	require.False(t, ok)
+	rules, ok := c.DescriptionRules("Security")
+	require.True(t, ok)
+	require.Error(t, rules.Validate("Patch the parser"))
+	require.NoError(t, rules.Validate("Patch CVE-2024-1234"))
+
+	val, err := json.Marshal(c)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"name":"Fixed", "increment":"patch"},
+		{"name":"Security", "increment":"patch", "descriptions": {"require": "CVE-\\d{4}-\\d+"}}
+		]`, string(val))
+}
+
+func TestUnmarshalIllegalDescriptionRules(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	err := json.Unmarshal([]byte(`[
+		{"name":"Security", "increment":"patch", "descriptions": {"require": "(CVE"}}
+		]`), c)
+	require.Error(t, err)
+}
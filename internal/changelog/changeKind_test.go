@@ -1,6 +1,7 @@
 package changelog
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/denisa/clq/internal/semver"
@@ -106,3 +107,101 @@ func TestNewChangeKindWithEmoji(t *testing.T) {
 		require.Equal(t, "🔒", emoji)
 	}
 }
+
+func TestOrderDefaultsToKeepAChangelog(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	var orders []int
+	for _, title := range []string{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"} {
+		order, ok := ck.Order(title)
+		require.True(t, ok)
+		orders = append(orders, order)
+	}
+	require.IsIncreasing(t, orders)
+	_, ok := ck.Order("Modified")
+	require.False(t, ok)
+}
+
+func TestOrderWithoutOrderInChangeMap(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"name":"Added", "increment":"major"},
+		{"name":"Fixed", "increment":"patch"},
+		{"name":"Documentation", "increment":"build"}
+		]`), c))
+	added, _ := c.Order("Added")
+	fixed, _ := c.Order("Fixed")
+	require.Less(t, added, fixed)
+	_, ok := c.Order("Documentation")
+	require.False(t, ok)
+	require.False(t, c.IsOrdered())
+}
+
+func TestOrderFromChangeMap(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"name":"Added", "increment":"major", "order": 2},
+		{"name":"Fixed", "increment":"patch", "order": 1},
+		{"name":"Security", "increment":"patch"},
+		{"name":"Tests", "increment":"build", "order": 10}
+		]`), c))
+	added, _ := c.Order("Added")
+	fixed, _ := c.Order("Fixed")
+	require.Less(t, fixed, added)
+	_, ok := c.Order("Security")
+	require.False(t, ok)
+	tests, _ := c.Order("Tests")
+	require.Equal(t, 10, tests)
+	require.True(t, c.IsOrdered())
+}
+
+func TestOrderNegative(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	require.Error(t, json.Unmarshal([]byte(`[{"name":"Fixed", "increment":"patch", "order": -1}]`), c))
+}
+
+func TestAliasesFromChangeMap(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"name":"Fixed", "increment":"patch", "emoji":"🐛", "aliases": ["Bug Fixes", "Fixes"]},
+		{"name":"Security", "increment":"patch"}
+		]`), c))
+	for name, expected := range map[string]string{"Fixed": "Fixed", "Fixes": "Fixed", "🐛 Bug Fixes": "Fixed", "Security": "Security"} {
+		require.Equal(t, expected, c.aliasOf(name), name)
+	}
+	_, ok := c.aliased("Bugs")
+	require.False(t, ok)
+}
+
+func TestAliasesNotChangeHeadings(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	require.NoError(t, json.Unmarshal([]byte(`[{"name":"Fixed", "increment":"patch", "emoji":"🐛", "aliases": ["Bug Fixes"]}]`), c))
+	for _, title := range []string{"Bug Fixes", "🐛 Bug Fixes"} {
+		kind, _ := c.kindOf(title)
+		require.Equal(t, title, kind)
+	}
+	kind, hasEmoji := c.kindOf("🐛 Fixed")
+	require.Equal(t, "Fixed", kind)
+	require.True(t, hasEmoji)
+}
+
+func TestAliasesConflict(t *testing.T) {
+	for _, changeMap := range []string{
+		`[{"name":"Fixed", "increment":"patch", "aliases": [""]}]`,
+		`[{"name":"Fixed", "increment":"patch", "aliases": ["Fixed"]}]`,
+		`[{"name":"Fixed", "increment":"patch"}, {"name":"Security", "increment":"patch", "aliases": ["Fixed"]}]`,
+		`[{"name":"Fixed", "increment":"patch", "aliases": ["Fixes"]}, {"name":"Fixes", "increment":"patch"}]`,
+		`[{"name":"Fixed", "increment":"patch", "aliases": ["Fixes"]}, {"name":"Security", "increment":"patch", "aliases": ["Fixes"]}]`,
+	} {
+		c := &ChangeKind{changes: make(changeKindToConfig)}
+		require.Error(t, json.Unmarshal([]byte(changeMap), c), changeMap)
+	}
+}
+
+func TestDesignates(t *testing.T) {
+	c := &ChangeKind{changes: make(changeKindToConfig)}
+	require.NoError(t, json.Unmarshal([]byte(`[{"name":"Fixed", "increment":"patch", "emoji":"🐛", "aliases": ["Bug Fixes"]}]`), c))
+	for _, name := range []string{"Fixed", "🐛 Fixed", "Bug Fixes", "🐛 Bug Fixes"} {
+		require.True(t, c.Designates(name), name)
+	}
+	require.False(t, c.Designates("Bogus"))
+}
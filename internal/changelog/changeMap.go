@@ -5,6 +5,15 @@ import "strings"
 // ChangeMap tracks the Changes that have been defined in a release
 type ChangeMap map[string]bool
 
+// Add adds all the changes of another ChangeMap.
+func (c ChangeMap) Add(other ChangeMap) {
+	for k, v := range other {
+		if v {
+			c[k] = true
+		}
+	}
+}
+
 func (c ChangeMap) String() string {
 	var changes []string
 	for k := range c {
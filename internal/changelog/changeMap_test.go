@@ -0,0 +1,13 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeMapAdd(t *testing.T) {
+	c := ChangeMap{"Added": true}
+	c.Add(ChangeMap{"Fixed": true, "Changed": false})
+	require.Equal(t, ChangeMap{"Added": true, "Fixed": true}, c)
+}
@@ -32,12 +32,41 @@ func (c *Changelog) Introduction() bool {
 
 // Release returns true if we’re currently visiting one of the Release sections.
 func (c *Changelog) Release() bool {
-	return len(c.headings) > 0 && c.active() == ReleaseHeading
+	if len(c.headings) == 0 {
+		return false
+	}
+	_, ok := c.headings[len(c.headings)-1].(Release)
+	return ok
+}
+
+// InSection returns true if we’re currently visiting one of the sections that are not releases.
+func (c *Changelog) InSection() bool {
+	if len(c.headings) == 0 {
+		return false
+	}
+	_, ok := c.headings[len(c.headings)-1].(Section)
+	return ok
 }
 
 // Change returns true if we’re currently visiting one of the Change sections.
 func (c *Changelog) Change() bool {
-	return len(c.headings) > 0 && (c.active() == ChangeHeading || c.active() == ChangeDescription)
+	return len(c.headings) > 0 && c.active() >= ChangeHeading
+}
+
+// Group returns true if we’re currently visiting one of the Group sections.
+func (c *Changelog) Group() bool {
+	_, ok := c.group()
+	return ok
+}
+
+// group returns the Group section currently visited, if any.
+func (c *Changelog) group() (Heading, bool) {
+	for i := len(c.headings) - 1; i > -1 && c.headings[i].Kind() >= GroupHeading; i-- {
+		if c.headings[i].Kind() == GroupHeading {
+			return c.headings[i], true
+		}
+	}
+	return nil, false
 }
 
 // active returns the kind of the top-most heading
@@ -45,39 +74,88 @@ func (c *Changelog) active() HeadingKind {
 	return c.headings[len(c.headings)-1].Kind()
 }
 
+// accepts returns true if a section of the given kind can follow the section currently visited.
+// A change description can directly follow a change, its group being optional.
+func (c *Changelog) accepts(kind HeadingKind) bool {
+	if len(c.headings) == 0 {
+		return kind == IntroductionHeading
+	}
+	active := c.active()
+	return kind <= active+1 || (kind == ChangeDescription && active == ChangeHeading)
+}
+
 // Close calls the registered Exit listeners for all the un-closed headings.
 func (c *Changelog) Close() {
-	for i := HeadingKind(len(c.headings)) - 1; i > -1; i-- {
+	for i := len(c.headings) - 1; i > -1; i-- {
 		for _, l := range c.listeners {
 			l.Exit(c.headings[i])
 		}
 	}
 }
 
-// Section sets the state to a new section kind with the given title.
+// Position returns the position of the section currently visited.
+func (c *Changelog) Position() Position {
+	if len(c.headings) == 0 {
+		return Position{}
+	}
+	return c.headings[len(c.headings)-1].Position()
+}
+
+// Section sets the state to a new section kind with the given title, starting at the given position.
 // Section can go down one-level, for example from Release to Change, or up any number of levels.
 // Section creates and returns the section’s Heading
-func (c *Changelog) Section(kind HeadingKind, title string) (Heading, error) {
-	if kind > HeadingKind(len(c.headings)) {
+func (c *Changelog) Section(kind HeadingKind, title string, position Position) (Heading, error) {
+	if !c.accepts(kind) {
 		return nil, fmt.Errorf("attempting to roll-back a changelog at %v to %v", len(c.headings), kind)
 	}
 
-	h, err := c.headingsFactory.NewHeading(kind, title)
+	h, err := c.headingsFactory.NewHeading(kind, title, position)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := HeadingKind(len(c.headings)) - 1; i >= kind; i-- {
+	c.enter(h)
+	return h, nil
+}
+
+// Description sets the state to a new change description, built with its nested change descriptions,
+// in the group currently visited, if any.
+func (c *Changelog) Description(item ChangeItem) error {
+	if !c.accepts(ChangeDescription) {
+		return fmt.Errorf("attempting to roll-back a changelog at %v to %v", len(c.headings), ChangeDescription)
+	}
+
+	if group, ok := c.group(); ok {
+		item.group = group.Title()
+	}
+	c.enter(item)
+	return nil
+}
+
+// EnterSection sets the state to a new section that is not a release, at the level of the releases.
+func (c *Changelog) EnterSection(section Section) error {
+	if !c.accepts(ReleaseHeading) {
+		return fmt.Errorf("attempting to roll-back a changelog at %v to %v", len(c.headings), ReleaseHeading)
+	}
+
+	c.enter(section)
+	return nil
+}
+
+// enter exits the sections at the level of the heading or below, then enters the heading.
+func (c *Changelog) enter(h Heading) {
+	kind := h.Kind()
+	i := len(c.headings) - 1
+	for ; i > -1 && c.headings[i].Kind() >= kind; i-- {
 		for _, l := range c.listeners {
 			l.Exit(c.headings[i])
 		}
 	}
 
-	c.headings = append(c.headings[:kind], h)
+	c.headings = append(c.headings[:i+1], h)
 	for _, l := range c.listeners {
 		l.Enter(h)
 	}
-	return h, nil
 }
 
 func (c *Changelog) String() string {
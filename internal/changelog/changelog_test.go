@@ -24,7 +24,7 @@ func TestOneLevelChangelogIsDocument(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
 	s := NewChangelog(hf)
-	_, _ = s.Section(IntroductionHeading, "title")
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
 
 	assertions.True(s.Introduction(), "title expected")
 	assertions.False(s.Release(), "release not expected")
@@ -37,8 +37,8 @@ func TestTwoLevelChangelogIsRelease(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
 	s := NewChangelog(hf)
-	_, _ = s.Section(IntroductionHeading, "title")
-	_, _ = s.Section(ReleaseHeading, "[Unreleased]")
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
 
 	assertions.False(s.Introduction(), "title not expected")
 	assertions.True(s.Release(), "release expected")
@@ -52,9 +52,9 @@ func TestThreeLevelChangelogIsChange(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
 	s := NewChangelog(hf)
-	_, _ = s.Section(IntroductionHeading, "title")
-	_, _ = s.Section(ReleaseHeading, "[Unreleased]")
-	_, _ = s.Section(ChangeHeading, "Added")
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
 
 	assertions.False(s.Introduction(), "title not expected")
 	assertions.False(s.Release(), "release not expected")
@@ -65,7 +65,7 @@ func TestResetChangelogSkipALevelShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
 	s := NewChangelog(hf)
-	_, err := s.Section(ReleaseHeading, "[Unreleased]")
+	_, err := s.Section(ReleaseHeading, "[Unreleased]", Position{})
 	require.Error(t, err)
 }
 
@@ -73,8 +73,8 @@ func TestResetChangelogToInvalidHeadingShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
 	s := NewChangelog(hf)
-	_, _ = s.Section(IntroductionHeading, "title")
-	_, err := s.Section(ReleaseHeading, "Unreleased")
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, err := s.Section(ReleaseHeading, "Unreleased", Position{})
 	require.Error(t, err)
 }
 
@@ -88,17 +88,17 @@ func TestResetFilledChangelogToZeroIsSameAsPushToEmptyChangelog(t *testing.T) {
 	s := NewChangelog(hf)
 	s.Listener(recorder)
 
-	_, _ = s.Section(IntroductionHeading, "title")
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
 	requireEventsEquals(assertions, &[]string{"Enter {title}"}, &recorder.events)
 
-	_, _ = s.Section(ReleaseHeading, "[Unreleased]")
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
 	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}"}, &recorder.events)
 
-	_, _ = s.Section(ChangeHeading, "Added")
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
 	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}", "Enter {Added}"}, &recorder.events)
 	assertions.Equal("{title}{[Unreleased]}{Added}", s.String())
 
-	_, err := s.Section(IntroductionHeading, "other title")
+	_, err := s.Section(IntroductionHeading, "other title", Position{})
 	assertions.NoError(err)
 	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}", "Enter {Added}", "Exit {Added}", "Exit {[Unreleased]}", "Exit {title}", "Enter {other title}"}, &recorder.events)
 	assertions.Equal("{other title}", s.String())
@@ -114,17 +114,17 @@ func TestResetFilledChangelogToOneIsSameAsTwoPushToEmptyChangelog(t *testing.T)
 	s := NewChangelog(hf)
 	s.Listener(recorder)
 
-	_, _ = s.Section(IntroductionHeading, "title")
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
 	requireEventsEquals(assertions, &[]string{"Enter {title}"}, &recorder.events)
 
-	_, _ = s.Section(ReleaseHeading, "[Unreleased]")
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
 	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}"}, &recorder.events)
 
-	_, _ = s.Section(ChangeHeading, "Added")
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
 	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}", "Enter {Added}"}, &recorder.events)
 	assertions.Equal("{title}{[Unreleased]}{Added}", s.String())
 
-	_, err := s.Section(ReleaseHeading, "[1.2.3] - 2020-04-15 [YANKED]")
+	_, err := s.Section(ReleaseHeading, "[1.2.3] - 2020-04-15 [YANKED]", Position{})
 	assertions.NoError(err)
 	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}", "Enter {Added}", "Exit {Added}", "Exit {[Unreleased]}", "Enter {[1.2.3] - 2020-04-15 [YANKED]}"}, &recorder.events)
 	assertions.Equal("{title}{[1.2.3] - 2020-04-15 [YANKED]}", s.String())
@@ -136,3 +136,151 @@ func requireEventsEquals(assertions *require.Assertions, expected *[]string, act
 		assertions.Equal((*expected)[i], (*actual)[i])
 	}
 }
+
+func TestChangelogPosition(t *testing.T) {
+	assertions := require.New(t)
+
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	assertions.Equal(Position{}, s.Position())
+
+	_, _ = s.Section(IntroductionHeading, "title", Position{Line: 1, Column: 3})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{Line: 3, Column: 4})
+	assertions.Equal(Position{Line: 3, Column: 4}, s.Position())
+}
+
+func TestChangelogDescription(t *testing.T) {
+	assertions := require.New(t)
+
+	recorder := &recorder{}
+
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	s.Listener(recorder)
+
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
+	detail, _ := hf.NewChangeItem("bar", Position{}, "", nil)
+	item, _ := hf.NewChangeItem("foo", Position{}, "", []ChangeItem{detail})
+	assertions.NoError(s.Description(item))
+	assertions.NoError(s.Description(detail))
+	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}", "Enter {Added}", "Enter {foo}", "Exit {foo}", "Enter {bar}"}, &recorder.events)
+	assertions.Equal("{title}{[Unreleased]}{Added}{bar}", s.String())
+}
+
+func TestChangelogDescriptionOutsideChangeShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	item, _ := hf.NewChangeItem("foo", Position{}, "", nil)
+	require.Error(t, s.Description(item))
+}
+
+func TestFourLevelChangelogIsGroup(t *testing.T) {
+	assertions := require.New(t)
+
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
+	assertions.False(s.Group(), "group not expected")
+
+	_, err := s.Section(GroupHeading, "api", Position{})
+	assertions.NoError(err)
+	item, _ := hf.NewChangeItem("foo", Position{}, "", nil)
+	assertions.NoError(s.Description(item))
+
+	assertions.True(s.Change(), "change expected")
+	assertions.True(s.Group(), "group expected")
+	assertions.Equal("{title}{[Unreleased]}{Added}{api}{foo}", s.String())
+}
+
+func TestChangelogGroupExits(t *testing.T) {
+	assertions := require.New(t)
+
+	recorder := &recorder{}
+
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	s.Listener(recorder)
+
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
+	item, _ := hf.NewChangeItem("foo", Position{}, "", nil)
+	assertions.NoError(s.Description(item))
+	_, _ = s.Section(GroupHeading, "api", Position{})
+	assertions.NoError(s.Description(item))
+	_, _ = s.Section(GroupHeading, "cli", Position{})
+	_, _ = s.Section(ChangeHeading, "Fixed", Position{})
+	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {[Unreleased]}", "Enter {Added}", "Enter {foo}", "Exit {foo}", "Enter {api}", "Enter {foo}", "Exit {foo}", "Exit {api}", "Enter {cli}", "Exit {cli}", "Exit {Added}", "Enter {Fixed}"}, &recorder.events)
+	assertions.False(s.Group(), "group not expected")
+	assertions.Equal("{title}{[Unreleased]}{Fixed}", s.String())
+}
+
+func TestChangelogDescriptionGroup(t *testing.T) {
+	assertions := require.New(t)
+
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	_, _ = s.Section(ChangeHeading, "Added", Position{})
+	item, _ := hf.NewChangeItem("foo", Position{}, "", nil)
+	assertions.NoError(s.Description(item))
+	assertions.Equal("", s.headings[len(s.headings)-1].(ChangeItem).Group())
+
+	_, _ = s.Section(GroupHeading, "api", Position{})
+	assertions.NoError(s.Description(item))
+	assertions.NoError(s.Description(item))
+	assertions.Equal("api", s.headings[len(s.headings)-1].(ChangeItem).Group())
+}
+
+func TestChangelogGroupOutsideChangeShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	_, err := s.Section(GroupHeading, "api", Position{})
+	require.Error(t, err)
+}
+
+func TestChangelogSection(t *testing.T) {
+	assertions := require.New(t)
+
+	recorder := &recorder{}
+
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	s.Listener(recorder)
+
+	_, _ = s.Section(IntroductionHeading, "title", Position{})
+	section, _ := hf.NewSection("Legend", Position{}, "")
+	assertions.NoError(s.EnterSection(section))
+	assertions.True(s.InSection(), "section expected")
+	assertions.False(s.Release(), "release not expected")
+
+	_, _ = s.Section(ReleaseHeading, "[Unreleased]", Position{})
+	assertions.False(s.InSection(), "section not expected")
+	assertions.True(s.Release(), "release expected")
+	requireEventsEquals(assertions, &[]string{"Enter {title}", "Enter {Legend}", "Exit {Legend}", "Enter {[Unreleased]}"}, &recorder.events)
+}
+
+func TestChangelogSectionWithoutIntroductionShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	s := NewChangelog(hf)
+	section, _ := hf.NewSection("Legend", Position{}, "")
+	require.Error(t, s.EnterSection(section))
+}
@@ -0,0 +1,137 @@
+package changelog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DescriptionRules constrains the text of the change descriptions. A rule left unset is not checked,
+// a rule set to null unsets the rule it overrides.
+type DescriptionRules struct {
+	// Require is a regular expression the description must match, like `#\d+` for an issue number.
+	Require string `json:"require,omitempty"`
+	// Forbid is a regular expression the description must not match.
+	Forbid string `json:"forbid,omitempty"`
+	// MinLength is the minimum length, in characters, of the description.
+	MinLength int `json:"minLength,omitempty"`
+	// MaxLength is the maximum length, in characters, of the description.
+	MaxLength int `json:"maxLength,omitempty"`
+	// Capitalized requires, or forbids, the description to start with a capital letter when it starts with a letter.
+	Capitalized *bool `json:"capitalized,omitempty"`
+	// Period requires, or forbids, the description to end with a period.
+	Period *bool `json:"period,omitempty"`
+
+	require, forbid *regexp.Regexp
+	// unset holds the json names of the rules set to null.
+	unset map[string]bool
+}
+
+type descriptionRulesDto DescriptionRules
+
+func (d *DescriptionRules) UnmarshalJSON(data []byte) error {
+	var dto descriptionRulesDto
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*d = DescriptionRules(dto)
+	for name, value := range fields {
+		if string(value) == "null" {
+			if d.unset == nil {
+				d.unset = make(map[string]bool)
+			}
+			d.unset[name] = true
+		}
+	}
+	return d.compile()
+}
+
+// compile compiles the regular expressions of the rules.
+func (d *DescriptionRules) compile() (err error) {
+	if d.require, err = compileRule("require", d.Require); err != nil {
+		return err
+	}
+	d.forbid, err = compileRule("forbid", d.Forbid)
+	return err
+}
+
+func compileRule(name string, expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q regular expression %q: %w", name, expr, err)
+	}
+	return re, nil
+}
+
+// Merge returns the rules overridden by the rules set in override, and without the rules override sets to null.
+func (d DescriptionRules) Merge(override DescriptionRules) DescriptionRules {
+	if override.Require != "" || override.unset["require"] {
+		d.Require, d.require = override.Require, override.require
+	}
+	if override.Forbid != "" || override.unset["forbid"] {
+		d.Forbid, d.forbid = override.Forbid, override.forbid
+	}
+	if override.MinLength != 0 || override.unset["minLength"] {
+		d.MinLength = override.MinLength
+	}
+	if override.MaxLength != 0 || override.unset["maxLength"] {
+		d.MaxLength = override.MaxLength
+	}
+	if override.Capitalized != nil || override.unset["capitalized"] {
+		d.Capitalized = override.Capitalized
+	}
+	if override.Period != nil || override.unset["period"] {
+		d.Period = override.Period
+	}
+	d.unset = nil
+	return d
+}
+
+// Validate returns an error joining the violations of the rules by the description, nil if there are none.
+func (d DescriptionRules) Validate(description string) error {
+	return errors.Join(d.Violations(description)...)
+}
+
+// Violations returns an error for every rule the description violates.
+func (d DescriptionRules) Violations(description string) []error {
+	var violations []error
+	if d.require != nil && !d.require.MatchString(description) {
+		violations = append(violations, fmt.Errorf("change description %q should match /%v/", description, d.Require))
+	}
+	if d.forbid != nil && d.forbid.MatchString(description) {
+		violations = append(violations, fmt.Errorf("change description %q should not match /%v/", description, d.Forbid))
+	}
+	length := utf8.RuneCountInString(description)
+	if d.MinLength > 0 && length < d.MinLength {
+		violations = append(violations, fmt.Errorf("change description %q is %d characters long, less than %d", description, length, d.MinLength))
+	}
+	if d.MaxLength > 0 && length > d.MaxLength {
+		violations = append(violations, fmt.Errorf("change description %q is %d characters long, more than %d", description, length, d.MaxLength))
+	}
+	if first, _ := utf8.DecodeRuneInString(description); d.Capitalized != nil && unicode.IsLetter(first) && unicode.IsUpper(first) != *d.Capitalized {
+		if *d.Capitalized {
+			violations = append(violations, fmt.Errorf("change description %q should start with a capital letter", description))
+		} else {
+			violations = append(violations, fmt.Errorf("change description %q should not start with a capital letter", description))
+		}
+	}
+	if period := strings.HasSuffix(strings.TrimSpace(description), "."); d.Period != nil && period != *d.Period {
+		if *d.Period {
+			violations = append(violations, fmt.Errorf("change description %q should end with a period", description))
+		} else {
+			violations = append(violations, fmt.Errorf("change description %q should not end with a period", description))
+		}
+	}
+	return violations
+}
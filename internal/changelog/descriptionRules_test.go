@@ -0,0 +1,97 @@
+package changelog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDescriptionRules(t *testing.T, data string) DescriptionRules {
+	var rules DescriptionRules
+	require.NoError(t, json.Unmarshal([]byte(data), &rules))
+	return rules
+}
+
+func TestDescriptionRulesUnset(t *testing.T) {
+	require.NoError(t, DescriptionRules{}.Validate("whatever, really."))
+}
+
+func TestDescriptionRulesRequire(t *testing.T) {
+	rules := newDescriptionRules(t, `{"require": "#\\d+|[A-Z]+-\\d+"}`)
+	require.NoError(t, rules.Validate("Fix the parser (#123)"))
+	require.NoError(t, rules.Validate("Fix the parser, ABC-123"))
+	require.Error(t, rules.Validate("Fix the parser"))
+}
+
+func TestDescriptionRulesForbid(t *testing.T) {
+	rules := newDescriptionRules(t, `{"forbid": "(?i)\\bwip\\b"}`)
+	require.NoError(t, rules.Validate("Wipe the cache"))
+	require.Error(t, rules.Validate("WIP: wipe the cache"))
+}
+
+func TestDescriptionRulesLength(t *testing.T) {
+	rules := newDescriptionRules(t, `{"minLength": 5, "maxLength": 8}`)
+	require.Error(t, rules.Validate("Fix"))
+	require.NoError(t, rules.Validate("Fix bug"))
+	require.NoError(t, rules.Validate("Réparé!!"))
+	require.Error(t, rules.Validate("Fix a bug"))
+}
+
+func TestDescriptionRulesCapitalized(t *testing.T) {
+	rules := newDescriptionRules(t, `{"capitalized": true}`)
+	require.NoError(t, rules.Validate("Fix a bug"))
+	require.NoError(t, rules.Validate("`-strict` option"))
+	require.Error(t, rules.Validate("fix a bug"))
+
+	rules = newDescriptionRules(t, `{"capitalized": false}`)
+	require.NoError(t, rules.Validate("fix a bug"))
+	require.Error(t, rules.Validate("Fix a bug"))
+}
+
+func TestDescriptionRulesPeriod(t *testing.T) {
+	rules := newDescriptionRules(t, `{"period": true}`)
+	require.NoError(t, rules.Validate("Fix a bug."))
+	require.Error(t, rules.Validate("Fix a bug"))
+
+	rules = newDescriptionRules(t, `{"period": false}`)
+	require.NoError(t, rules.Validate("Fix a bug"))
+	require.Error(t, rules.Validate("Fix a bug."))
+}
+
+func TestDescriptionRulesViolations(t *testing.T) {
+	rules := newDescriptionRules(t, `{"capitalized": true, "period": false}`)
+	require.Empty(t, rules.Violations("Fix a bug"))
+	violations := rules.Violations("lower case end.")
+	require.Len(t, violations, 2)
+	require.EqualError(t, violations[0], `change description "lower case end." should start with a capital letter`)
+	require.EqualError(t, violations[1], `change description "lower case end." should not end with a period`)
+	require.EqualError(t, rules.Validate("lower case end."), violations[0].Error()+"\n"+violations[1].Error())
+}
+
+func TestDescriptionRulesIllegalRegularExpression(t *testing.T) {
+	var rules DescriptionRules
+	require.Error(t, json.Unmarshal([]byte(`{"require": "(CVE"}`), &rules))
+	require.Error(t, json.Unmarshal([]byte(`{"forbid": "[a-"}`), &rules))
+}
+
+func TestDescriptionRulesMerge(t *testing.T) {
+	rules := newDescriptionRules(t, `{"require": "#\\d+", "maxLength": 20, "period": false}`).
+		Merge(newDescriptionRules(t, `{"require": "CVE-\\d+", "capitalized": true}`))
+	require.Equal(t, "CVE-\\d+", rules.Require)
+	require.Equal(t, 20, rules.MaxLength)
+	require.NoError(t, rules.Validate("Patch CVE-2024"))
+	require.Error(t, rules.Validate("Patch #12"))
+	require.Error(t, rules.Validate("patch CVE-2024"))
+	require.Error(t, rules.Validate("Patch CVE-2024."))
+}
+
+func TestDescriptionRulesMergeNull(t *testing.T) {
+	rules := newDescriptionRules(t, `{"require": "#\\d+", "maxLength": 20, "period": false}`).
+		Merge(newDescriptionRules(t, `{"require": null, "maxLength": null, "period": null, "capitalized": true}`))
+	require.Equal(t, "", rules.Require)
+	require.Equal(t, 0, rules.MaxLength)
+	require.Nil(t, rules.Period)
+	require.NoError(t, rules.Validate("Patch the parser, for a description longer than twenty characters."))
+	require.Error(t, rules.Validate("patch the parser"))
+}
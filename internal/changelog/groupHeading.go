@@ -0,0 +1,35 @@
+package changelog
+
+import "fmt"
+
+// Group is a level 4 heading grouping the change descriptions of a change by scope, like a component of a monorepo
+type Group struct {
+	heading
+}
+
+func (h HeadingsFactory) newGroup(title string, position Position) (Heading, error) {
+	if title == "" {
+		return nil, fmt.Errorf("validation error: group cannot stay empty")
+	}
+	return Group{heading{title: title, kind: GroupHeading, position: position}}, nil
+}
+
+func (h Group) DisplayTitle() string {
+	return h.Title()
+}
+
+func (h Group) Title() string {
+	return h.title
+}
+
+func (h Group) Kind() HeadingKind {
+	return h.kind
+}
+
+func (h Group) Position() Position {
+	return h.position
+}
+
+func (h Group) String() string {
+	return asPath(h.title)
+}
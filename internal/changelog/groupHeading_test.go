@@ -0,0 +1,22 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHeadingGroup(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	h, _ := hf.NewHeading(GroupHeading, "api", Position{})
+	requireHeadingInterface(t, "api", h)
+	require.Equal(t, GroupHeading, h.Kind())
+}
+
+func TestEmptyGroupShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	_, err := hf.newGroup("", Position{})
+	require.Error(t, err)
+}
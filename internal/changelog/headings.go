@@ -7,6 +7,7 @@ const (
 	IntroductionHeading HeadingKind = iota
 	ReleaseHeading
 	ChangeHeading
+	GroupHeading
 	ChangeDescription
 )
 
@@ -18,12 +19,15 @@ type Heading interface {
 	DisplayTitle() string
 	// Kind is the HeadingKind for the section
 	Kind() HeadingKind
+	// Position is where the section starts in the changelog source
+	Position() Position
 	String() string
 }
 
 type heading struct {
-	title string
-	kind  HeadingKind
+	title    string
+	kind     HeadingKind
+	position Position
 }
 
 func asPath(name string) string {
@@ -12,17 +12,19 @@ func NewHeadingFactory(changeKind *ChangeKind) HeadingsFactory {
 	return HeadingsFactory{changeKind: changeKind}
 }
 
-// NewHeading is the factory method that, given a kind and a title, returns the appropriate Heading.
-func (h HeadingsFactory) NewHeading(kind HeadingKind, title string) (Heading, error) {
+// NewHeading is the factory method that, given a kind, a title and its position, returns the appropriate Heading.
+func (h HeadingsFactory) NewHeading(kind HeadingKind, title string, position Position) (Heading, error) {
 	switch kind {
 	case IntroductionHeading:
-		return h.newIntroduction(title)
+		return h.newIntroduction(title, position)
 	case ReleaseHeading:
-		return h.newRelease(title)
+		return h.newRelease(title, position)
 	case ChangeHeading:
-		return h.newChange(title)
+		return h.newChange(title, position)
+	case GroupHeading:
+		return h.newGroup(title, position)
 	case ChangeDescription:
-		return h.newChangeItem(title)
+		return h.newChangeItem(title, position)
 	}
 	return nil, fmt.Errorf("unknown heading kind %v", kind)
 }
@@ -9,6 +9,28 @@ import (
 func TestNewHeadingUnknown(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.NewHeading(-1, "Who knows what")
+	_, err := hf.NewHeading(-1, "Who knows what", Position{})
 	require.Error(t, err)
 }
+
+func TestNewHeadingPosition(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	testcases := []struct {
+		kind  HeadingKind
+		title string
+	}{
+		{IntroductionHeading, "changelog"},
+		{ReleaseHeading, "[1.2.3] - 2020-04-15"},
+		{ChangeHeading, "Added"},
+		{GroupHeading, "api"},
+		{ChangeDescription, "foo"},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.title, func(t *testing.T) {
+			h, err := hf.NewHeading(testcase.kind, testcase.title, Position{Line: 12, Column: 4})
+			require.NoError(t, err)
+			require.Equal(t, Position{Line: 12, Column: 4}, h.Position())
+		})
+	}
+}
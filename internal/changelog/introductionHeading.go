@@ -9,11 +9,11 @@ type Introduction struct {
 	heading
 }
 
-func (h HeadingsFactory) newIntroduction(title string) (Heading, error) {
+func (h HeadingsFactory) newIntroduction(title string, position Position) (Heading, error) {
 	if title == "" {
 		return nil, fmt.Errorf("validation error: Introduction’s title cannot stay empty")
 	}
-	return Introduction{heading{title: title, kind: IntroductionHeading}}, nil
+	return Introduction{heading{title: title, kind: IntroductionHeading, position: position}}, nil
 }
 
 func (h Introduction) DisplayTitle() string {
@@ -28,6 +28,10 @@ func (h Introduction) Kind() HeadingKind {
 	return h.kind
 }
 
+func (h Introduction) Position() Position {
+	return h.position
+}
+
 func (h Introduction) String() string {
 	return asPath(h.title)
 }
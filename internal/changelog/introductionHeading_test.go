@@ -9,20 +9,20 @@ import (
 func TestNewHeadingIntroduction(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.NewHeading(IntroductionHeading, "changelog")
+	h, _ := hf.NewHeading(IntroductionHeading, "changelog", Position{})
 	requireHeadingInterface(t, "changelog", h)
 }
 
 func TestIntroduction(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newIntroduction("changelog")
+	h, _ := hf.newIntroduction("changelog", Position{})
 	requireHeadingInterface(t, "changelog", h)
 }
 
 func TestEmptyIntroductionShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newIntroduction("")
+	_, err := hf.newIntroduction("", Position{})
 	require.Error(t, err)
 }
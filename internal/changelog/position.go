@@ -0,0 +1,22 @@
+package changelog
+
+import "fmt"
+
+// Position locates a heading in the changelog source.
+// Line and Column start at 1; the zero Position is unknown.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// IsValid returns true if the position is known.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Position) String() string {
+	if !p.IsValid() {
+		return "-"
+	}
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
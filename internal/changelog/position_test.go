@@ -0,0 +1,21 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionUnknown(t *testing.T) {
+	assertions := require.New(t)
+	p := Position{}
+	assertions.False(p.IsValid())
+	assertions.Equal("-", p.String())
+}
+
+func TestPosition(t *testing.T) {
+	assertions := require.New(t)
+	p := Position{Line: 123, Column: 4}
+	assertions.True(p.IsValid())
+	assertions.Equal("123:4", p.String())
+}
@@ -13,7 +13,7 @@ func TestEnter(t *testing.T) {
 	assertions.Equal(len(r.events), 0)
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.4] - 2020-04-15")
+	h, _ := hf.newRelease("[1.2.4] - 2020-04-15", Position{})
 	r.Enter(h)
 	assertions.Equal(len(r.events), 1)
 	assertions.Equal(r.events[0], "Enter "+h.String())
@@ -26,7 +26,7 @@ func TestExit(t *testing.T) {
 	assertions.Equal(len(r.events), 0)
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.4] - 2020-04-15")
+	h, _ := hf.newRelease("[1.2.4] - 2020-04-15", Position{})
 	r.Exit(h)
 	assertions.Equal(len(r.events), 1)
 	assertions.Equal(r.events[0], "Exit "+h.String())
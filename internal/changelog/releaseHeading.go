@@ -3,6 +3,7 @@ package changelog
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -11,21 +12,32 @@ import (
 
 type Release struct {
 	heading
-	label   string
-	yanked  bool
-	date    time.Time
-	version semver.Version
+	label        string
+	yanked       bool
+	yankedReason string
+	replacement  *semver.Version
+	date         time.Time
+	version      semver.Version
+	url          string
 }
 
 const semverPattern string = `(?P<semver>\S+)`
 const isoDatePattern string = `(?:\s+(?P<date>\d{4}-\d{2}-\d{2}))?`
 
-func (h HeadingsFactory) newRelease(title string) (Heading, error) {
-	if matched, _ := regexp.MatchString(`^\[\s*Unreleased\s*]$`, title); matched {
-		return Release{heading: heading{title: title, kind: ReleaseHeading}}, nil
+// urlPattern matches the destination, and optional title, of an inline link on the version, like `[1.2.0](https://host)`.
+const urlPattern string = `(?:\((?P<url>[^\s)]*)(?:\s+"[^"]*")?\))?`
+
+// yankedReasonRE matches the reason following the yanked marker, like `reason: data loss, use 1.2.4`,
+// with its optional replacement version.
+var yankedReasonRE = regexp.MustCompile(`(?i)^reason:\s*(?P<reason>.*?)(?:,?\s*\buse\s+(?P<replacement>v?\d\S*?))?\.?$`)
+
+func (h HeadingsFactory) newRelease(title string, position Position) (Heading, error) {
+	unreleasedRE := regexp.MustCompile(`^\[\s*Unreleased\s*]` + urlPattern + `$`)
+	if matches := unreleasedRE.FindStringSubmatch(title); matches != nil {
+		return Release{heading: heading{title: title, kind: ReleaseHeading, position: position}, url: matches[unreleasedRE.SubexpIndex("url")]}, nil
 	}
 	{
-		releaseRE := regexp.MustCompile(`^\[\s*` + semverPattern + `\s*\](?P<versionDateSeparator>\s+-)?` + isoDatePattern + `(?:\s+(?P<yanked>\[\s*YANKED\s*]))?` + `(?:\s+(?P<label>.+))?$`)
+		releaseRE := regexp.MustCompile(`^\[\s*` + semverPattern + `\s*\]` + urlPattern + `(?P<versionDateSeparator>\s+-)?` + isoDatePattern + `(?:\s+(?P<yanked>\[\s*YANKED\s*]))?` + `(?:\s+(?P<label>.+))?$`)
 		if matches := releaseRE.FindStringSubmatch(title); matches != nil {
 			groups := releaseRE.SubexpNames()
 			index := subexp(groups, "semver")
@@ -51,12 +63,45 @@ func (h HeadingsFactory) newRelease(title string) (Heading, error) {
 				return nil, fmt.Errorf("validation error: Illegal date (%v) for %v", err, title)
 			}
 
-			return Release{heading: heading{title: title, kind: ReleaseHeading}, date: date, version: version, label: matches[subexp(groups, "label")], yanked: matches[subexp(groups, "yanked")] != ""}, nil
+			release := Release{heading: heading{title: title, kind: ReleaseHeading, position: position}, date: date, version: version, label: matches[subexp(groups, "label")], yanked: matches[subexp(groups, "yanked")] != "", url: matches[subexp(groups, "url")]}
+			if release.yanked {
+				if err := release.parseYankedReason(); err != nil {
+					return nil, err
+				}
+			}
+			return release, nil
 		}
 	}
 	return nil, fmt.Errorf("validation error: Unknown release header for %q", title)
 }
 
+// parseYankedReason moves a label starting with `reason:` to the reason, and replacement version, of the yanked release.
+func (h *Release) parseYankedReason() error {
+	matches := yankedReasonRE.FindStringSubmatch(h.label)
+	if matches == nil {
+		return nil
+	}
+	h.label = ""
+	h.yankedReason = matches[yankedReasonRE.SubexpIndex("reason")]
+	if replacement := matches[yankedReasonRE.SubexpIndex("replacement")]; replacement != "" {
+		version, err := semver.Parse(strings.TrimPrefix(replacement, "v"))
+		if err != nil {
+			return fmt.Errorf("validation error: Illegal replacement version (%v) for %v", err, h.title)
+		}
+		h.replacement = &version
+	}
+	return nil
+}
+
+// NormalizeVersion returns the semantic version of a version or a tag name, ignoring any `v` prefix.
+func NormalizeVersion(name string) (string, error) {
+	version, err := semver.Parse(strings.TrimPrefix(strings.TrimSpace(name), "v"))
+	if err != nil {
+		return "", err
+	}
+	return version.String(), nil
+}
+
 func (h Release) DisplayTitle() string {
 	return h.Title()
 }
@@ -68,6 +113,10 @@ func (h Release) Kind() HeadingKind {
 	return h.kind
 }
 
+func (h Release) Position() Position {
+	return h.position
+}
+
 func (h Release) String() string {
 	return asPath(h.title)
 }
@@ -80,6 +129,11 @@ func (h Release) Date() string {
 	return ""
 }
 
+// URL returns the destination of the link on the version of this release, if any, an empty string otherwise.
+func (h Release) URL() string {
+	return h.url
+}
+
 // Label returns the optional label of this release.
 func (h Release) Label() string {
 	return h.label
@@ -98,27 +152,37 @@ func (h Release) IsPrerelease() bool {
 	return h.HasBeenReleased() && len(h.version.Pre) > 0 && len(h.version.Build) == 0
 }
 
-// IsRelease returns true if this release is a release without pre-release or build number component.
-func (h Release) IsRelease() bool {
-	return h.HasBeenReleased() && len(h.version.Pre) == 0 && len(h.version.Build) == 0
-}
-
 // HasBeenYanked returns true if this release has been yanked.
 func (h Release) HasBeenYanked() bool {
 	return h.yanked
 }
 
+// YankedReason returns the optional reason why this release has been yanked.
+func (h Release) YankedReason() string {
+	return h.yankedReason
+}
+
+// Replacement returns the version replacing this yanked release, if any, an empty string otherwise.
+func (h Release) Replacement() string {
+	if h.replacement == nil {
+		return ""
+	}
+	return h.replacement.String()
+}
+
+// HasNewerReplacement returns true if the version replacing this yanked release is greater than its version.
+func (h Release) HasNewerReplacement() bool {
+	return h.replacement != nil && h.replacement.GT(h.version)
+}
+
 // HasBeenReleased returns true if this has ever been released
 func (h Release) HasBeenReleased() bool {
 	return !h.date.IsZero()
 }
 
-// ReleaseIs returns true this release is equal to the otherRelease
-func (h Release) ReleaseIs(otherRelease semver.Version) bool {
-	return h.version.EQ(otherRelease)
-}
-
 // NextRelease computes what the next version number should be given a set of changes.
+// A prerelease increment bumps the last numeric identifier of the prerelease, and keeps a final release
+// as is; a build increment keeps the version but its build metadata.
 func (h Release) NextRelease(semverIdentifier semverConstants.Identifier) semver.Version {
 	switch semverIdentifier {
 	case semverConstants.Major:
@@ -127,28 +191,93 @@ func (h Release) NextRelease(semverIdentifier semverConstants.Identifier) semver
 		return semver.Version{Major: h.version.Major, Minor: h.version.Minor + 1, Patch: 0}
 	case semverConstants.Patch:
 		return semver.Version{Major: h.version.Major, Minor: h.version.Minor, Patch: h.version.Patch + 1}
+	case semverConstants.Prerelease:
+		if len(h.version.Pre) == 0 {
+			return semver.Version{Major: h.version.Major, Minor: h.version.Minor, Patch: h.version.Patch}
+		}
+		pre := append([]semver.PRVersion(nil), h.version.Pre...)
+		if last := pre[len(pre)-1]; last.IsNum {
+			pre[len(pre)-1] = semver.PRVersion{VersionNum: last.VersionNum + 1, IsNum: true}
+		} else {
+			pre = append(pre, semver.PRVersion{VersionNum: 0, IsNum: true})
+		}
+		return semver.Version{Major: h.version.Major, Minor: h.version.Minor, Patch: h.version.Patch, Pre: pre}
+	case semverConstants.Build:
+		return semver.Version{Major: h.version.Major, Minor: h.version.Minor, Patch: h.version.Patch, Pre: h.version.Pre}
 	default:
 		return semver.Version{Major: h.version.Major, Minor: h.version.Minor, Patch: h.version.Patch}
 	}
 }
 
-// IsNewerThan returns an error if this release if not newer than another release.
-// A release is newer if it has the same or a more recent date and one its
-// version has been incremented.
-func (h Release) IsNewerThan(other Release) error {
+// HasPrerelease returns true if the version of this release has a pre-release component.
+func (h Release) HasPrerelease() bool {
+	return len(h.version.Pre) > 0
+}
+
+// HasSameCore returns true if this release and the other release share their major, minor and patch versions.
+func (h Release) HasSameCore(other Release) bool {
+	return h.version.Major == other.version.Major && h.version.Minor == other.version.Minor && h.version.Patch == other.version.Patch
+}
+
+// HasSamePrerelease returns true if this release and the other release only differ by their build metadata.
+func (h Release) HasSamePrerelease(other Release) bool {
+	return h.HasSameCore(other) && comparePrerelease(h.version.Pre, other.version.Pre) == 0
+}
+
+// CoreIs returns true if the major, minor and patch versions of this release are those of the version.
+func (h Release) CoreIs(version semver.Version) bool {
+	return h.version.Major == version.Major && h.version.Minor == version.Minor && h.version.Patch == version.Patch
+}
+
+// IsNotOlderThan returns an error if this release is dated before another release.
+func (h Release) IsNotOlderThan(other Release) error {
 	if h.date.Before(other.date) {
 		return fmt.Errorf("validation error: release %q should be older than %q", other.Title(), h.Title())
 	}
+	return nil
+}
+
+// SortsAfter returns an error if the version of this release does not have a greater precedence than the version
+// of another release. As per semantic versioning, the build metadata does not count.
+func (h Release) SortsAfter(other Release) error {
 	if h.version.LTE(other.version) {
 		return fmt.Errorf("validation error: release %q should sort before %q", other.Title(), h.Title())
 	}
 	return nil
 }
 
+// IsNotInTheFuture returns an error if this release is dated after today.
+func (h Release) IsNotInTheFuture(today time.Time) error {
+	if h.date.After(today) {
+		return fmt.Errorf("release %q is dated in the future", h.Title())
+	}
+	return nil
+}
+
+// IsNotOlderThanDays returns an error if this release is dated more than a number of days before today.
+func (h Release) IsNotOlderThanDays(today time.Time, days int) error {
+	if h.date.Before(today.AddDate(0, 0, -days)) {
+		if days == 0 {
+			return fmt.Errorf("release %q should be dated %v", h.Title(), today.Format("2006-01-02"))
+		}
+		return fmt.Errorf("release %q should be dated at most %d days before %v", h.Title(), days, today.Format("2006-01-02"))
+	}
+	return nil
+}
+
 func (h Release) IsMajorVersionZero() bool {
 	return h.version.Major == 0
 }
 
+func comparePrerelease(a, b []semver.PRVersion) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := a[i].Compare(b[i]); c != 0 {
+			return c
+		}
+	}
+	return len(a) - len(b)
+}
+
 func subexp(groups []string, groupName string) int {
 	for index, name := range groups {
 		if name == groupName {
@@ -2,6 +2,7 @@ package changelog
 
 import (
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	semverConstants "github.com/denisa/clq/internal/semver"
@@ -11,21 +12,20 @@ import (
 func TestNewHeadingRelease(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.NewHeading(ReleaseHeading, "[Unreleased]")
+	h, _ := hf.NewHeading(ReleaseHeading, "[Unreleased]", Position{})
 	requireHeadingInterface(t, "[Unreleased]", h)
 }
 
 func TestReleaseUnreleased(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[Unreleased]")
+	h, _ := hf.newRelease("[Unreleased]", Position{})
 	r, _ := h.(Release)
 
 	assertions := require.New(t)
 	assertions.Empty(r.Version())
 	assertions.Empty(r.Date())
 	assertions.Empty(r.Label())
-	assertions.False(r.IsRelease())
 	assertions.False(r.IsPrerelease())
 	assertions.False(r.HasBeenYanked())
 	assertions.False(r.HasBeenReleased())
@@ -34,14 +34,13 @@ func TestReleaseUnreleased(t *testing.T) {
 func TestReleasePrereleasedNoLabel(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.3-rc.1] - 2020-04-15")
+	h, _ := hf.newRelease("[1.2.3-rc.1] - 2020-04-15", Position{})
 	r, _ := h.(Release)
 
 	assertions := require.New(t)
 	assertions.Equal("1.2.3-rc.1", r.Version())
 	assertions.Equal("2020-04-15", r.Date())
 	assertions.Empty(r.Label())
-	assertions.False(r.IsRelease())
 	assertions.True(r.IsPrerelease())
 	assertions.False(r.HasBeenYanked())
 	assertions.True(r.HasBeenReleased())
@@ -50,14 +49,13 @@ func TestReleasePrereleasedNoLabel(t *testing.T) {
 func TestReleaseReleasedNoLabel(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.3] - 2020-04-15")
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-15", Position{})
 	r, _ := h.(Release)
 
 	assertions := require.New(t)
 	assertions.Equal("1.2.3", r.Version())
 	assertions.Equal("2020-04-15", r.Date())
 	assertions.Empty(r.Label())
-	assertions.True(r.IsRelease())
 	assertions.False(r.IsPrerelease())
 	assertions.False(r.HasBeenYanked())
 	assertions.True(r.HasBeenReleased())
@@ -66,14 +64,13 @@ func TestReleaseReleasedNoLabel(t *testing.T) {
 func TestReleaseReleasedLabel(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.3] - 2020-04-15 Espelho")
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-15 Espelho", Position{})
 	r, _ := h.(Release)
 
 	assertions := require.New(t)
 	assertions.Equal("1.2.3", r.Version())
 	assertions.Equal("2020-04-15", r.Date())
 	assertions.Equal("Espelho", r.Label())
-	assertions.True(r.IsRelease())
 	assertions.False(r.IsPrerelease())
 	assertions.False(r.HasBeenYanked())
 	assertions.True(r.HasBeenReleased())
@@ -82,70 +79,69 @@ func TestReleaseReleasedLabel(t *testing.T) {
 func TestReleaseEmptyVersionShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[] - 2020-02-15")
+	_, err := hf.newRelease("[] - 2020-02-15", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseNotaSemanticVersionShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[alpha-vingt-trois] - 2020-02-15")
+	_, err := hf.newRelease("[alpha-vingt-trois] - 2020-02-15", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseNoSeparatorShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.2.3] 2020-02-15")
+	_, err := hf.newRelease("[1.2.3] 2020-02-15", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseMissingDateShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.2.3] - ")
+	_, err := hf.newRelease("[1.2.3] - ", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseNonIsoDateSeparatorShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.2.3] - 2020.02.15")
+	_, err := hf.newRelease("[1.2.3] - 2020.02.15", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseNonIsoDateSingleDigitShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.2.3] - 2020-4-1")
+	_, err := hf.newRelease("[1.2.3] - 2020-4-1", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseReleasedDateNotInCalendar(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.2.3] - 2020-02-30")
+	_, err := hf.newRelease("[1.2.3] - 2020-02-30", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseReleasedVersionShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.02] - 2020-02-15")
+	_, err := hf.newRelease("[1.02] - 2020-02-15", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseYanked(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.3] - 2020-04-15 [YANKED]")
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-15 [YANKED]", Position{})
 	r, _ := h.(Release)
 
 	assertions := require.New(t)
 	assertions.Equal("1.2.3", r.Version())
 	assertions.Equal("2020-04-15", r.Date())
 	assertions.Empty(r.Label())
-	assertions.True(r.IsRelease())
 	assertions.True(r.HasBeenYanked())
 	assertions.True(r.HasBeenReleased())
 }
@@ -153,113 +149,273 @@ func TestReleaseYanked(t *testing.T) {
 func TestReleaseYankedDateShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.2.3] - 2020-02-30 [YANKED]")
+	_, err := hf.newRelease("[1.2.3] - 2020-02-30 [YANKED]", Position{})
 	require.Error(t, err)
 }
 
 func TestReleaseYankedVersionShouldFail(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	_, err := hf.newRelease("[1.02] - 2020-02-15 [YANKED]")
+	_, err := hf.newRelease("[1.02] - 2020-02-15 [YANKED]", Position{})
 	require.Error(t, err)
 }
 
-func TestReleaseVersionEquality(t *testing.T) {
-	ck, _ := NewChangeKind("")
-	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.3] - 2020-04-15")
-	r, _ := h.(Release)
+func TestNextRelease(t *testing.T) {
+	testcases := []struct {
+		semverIdentifier semverConstants.Identifier
+		expected         semver.Version
+	}{
+		{semverConstants.Major, semver.Version{Major: 1, Minor: 0, Patch: 0}},
+		{semverConstants.Minor, semver.Version{Major: 0, Minor: 1, Patch: 0}},
+		{semverConstants.Patch, semver.Version{Major: 0, Minor: 0, Patch: 1}},
+		{semverConstants.Prerelease, semver.Version{}},
+		{semverConstants.Build, semver.Version{}},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.expected.String(), func(t *testing.T) {
+			r := &Release{}
+			require.Equal(t, testcase.expected, r.NextRelease(testcase.semverIdentifier))
+		})
+	}
+}
 
-	assertions := require.New(t)
-	{
-		v, _ := semver.Make("1.2.3")
-		assertions.True(r.ReleaseIs(v))
+func TestNextReleaseOfPrerelease(t *testing.T) {
+	testcases := []struct {
+		version          string
+		semverIdentifier semverConstants.Identifier
+		expected         string
+	}{
+		{"1.2.0-rc.1", semverConstants.Prerelease, "1.2.0-rc.2"},
+		{"1.2.0-rc", semverConstants.Prerelease, "1.2.0-rc.0"},
+		{"1.2.0", semverConstants.Prerelease, "1.2.0"},
+		{"1.2.0-rc.1+build.5", semverConstants.Build, "1.2.0-rc.1"},
+		{"1.2.0-rc.1", semverConstants.Patch, "1.2.1"},
+		{"1.2.0+build.5", semverConstants.Minor, "1.3.0"},
 	}
-	{
-		v, _ := semver.Make("1.2.4")
-		assertions.False(r.ReleaseIs(v))
+	for _, testcase := range testcases {
+		t.Run(testcase.version, func(t *testing.T) {
+			r := Release{version: semver.MustParse(testcase.version)}
+			require.Equal(t, testcase.expected, r.NextRelease(testcase.semverIdentifier).String())
+		})
 	}
 }
 
-func TestReleaseOrdering(t *testing.T) {
+func TestReleaseSortsAfterPrereleaseAndBuild(t *testing.T) {
+	testcases := []struct {
+		newer, older string
+		sortsAfter   bool
+	}{
+		{"1.2.0-rc.2", "1.2.0-rc.1", true},
+		{"1.2.0", "1.2.0-rc.2", true},
+		{"1.2.0-rc.1", "1.2.0-rc.2", false},
+		{"1.3.0+build.1", "1.2.0+build.5", true},
+		{"1.2.0+build.5", "1.2.0", false},
+		{"1.2.0+build.10", "1.2.0+build.9", false},
+		{"1.2.0+build.5", "1.2.0+build.5", false},
+		{"1.2.0", "1.2.0+build.5", false},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.newer+" after "+testcase.older, func(t *testing.T) {
+			newer := Release{version: semver.MustParse(testcase.newer)}
+			older := Release{version: semver.MustParse(testcase.older)}
+			if testcase.sortsAfter {
+				require.NoError(t, newer.SortsAfter(older))
+			} else {
+				require.Error(t, newer.SortsAfter(older))
+			}
+		})
+	}
+}
+
+func TestReleaseVersionComponents(t *testing.T) {
+	rc1 := Release{version: semver.MustParse("1.2.0-rc.1")}
+	rc1Build := Release{version: semver.MustParse("1.2.0-rc.1+build.5")}
+	final := Release{version: semver.MustParse("1.2.0")}
+	patch := Release{version: semver.MustParse("1.2.1")}
+
+	assertions := require.New(t)
+	assertions.True(rc1.HasPrerelease())
+	assertions.False(final.HasPrerelease())
+	assertions.True(rc1.HasSameCore(final))
+	assertions.False(patch.HasSameCore(final))
+	assertions.True(rc1.HasSamePrerelease(rc1Build))
+	assertions.False(rc1.HasSamePrerelease(final))
+	assertions.True(rc1Build.CoreIs(semver.MustParse("1.2.0")))
+	assertions.False(patch.CoreIs(semver.MustParse("1.2.0")))
+}
+
+func TestSubexp(t *testing.T) {
+	require.Equal(t, 1, subexp([]string { "group1", "group2" }, "group2") )
+}
+
+func TestSubexpPanic(t *testing.T) {
+	require.PanicsWithValue(t, "Group `groupNotPresent` missing from regular expression", func() { subexp([]string { "group1", "group2" }, "groupNotPresent") } )
+}
+
+func TestReleaseIsNotOlderThan(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.4] - 2020-04-16")
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-16", Position{})
 	r1, _ := h.(Release)
 
-	h, _ = hf.newRelease("[1.2.3] - 2020-04-15")
+	h, _ = hf.newRelease("[1.2.4] - 2020-04-15", Position{})
 	r2, _ := h.(Release)
 
 	assertions := require.New(t)
-	assertions.NoError(r1.IsNewerThan(r2))
-	assertions.Error(r2.IsNewerThan(r1))
+	assertions.NoError(r1.IsNotOlderThan(r2))
+	assertions.EqualError(r2.IsNotOlderThan(r1), "validation error: release \"[1.2.3] - 2020-04-16\" should be older than \"[1.2.4] - 2020-04-15\"")
 }
 
-func TestReleaseOrderingSameDay(t *testing.T) {
+func TestReleaseSortsAfter(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.4] - 2020-04-16")
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-16", Position{})
 	r1, _ := h.(Release)
 
-	h, _ = hf.newRelease("[1.2.3] - 2020-04-16")
+	h, _ = hf.newRelease("[1.2.4] - 2020-04-15", Position{})
 	r2, _ := h.(Release)
 
 	assertions := require.New(t)
-	assertions.NoError(r1.IsNewerThan(r2))
-	assertions.Error(r2.IsNewerThan(r1))
+	assertions.NoError(r2.SortsAfter(r1))
+	assertions.EqualError(r1.SortsAfter(r2), "validation error: release \"[1.2.4] - 2020-04-15\" should sort before \"[1.2.3] - 2020-04-16\"")
 }
 
-func TestReleaseOrderingSameVersionShouldFail(t *testing.T) {
+func TestReleaseIsNotInTheFuture(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.4] - 2020-04-16")
-	r1, _ := h.(Release)
-
-	h, _ = hf.newRelease("[1.2.4] - 2020-04-15")
-	r2, _ := h.(Release)
+	today := time.Date(2020, 4, 16, 0, 0, 0, 0, time.UTC)
 
 	assertions := require.New(t)
-	assertions.Error(r1.IsNewerThan(r2))
-	assertions.Error(r2.IsNewerThan(r1))
+	for title, inTheFuture := range map[string]bool{"[1.2.3] - 2020-04-15": false, "[1.2.3] - 2020-04-16": false, "[1.2.3] - 2020-04-17": true, "[Unreleased]": false} {
+		h, _ := hf.newRelease(title, Position{})
+		err := h.(Release).IsNotInTheFuture(today)
+		if inTheFuture {
+			assertions.Error(err, title)
+		} else {
+			assertions.NoError(err, title)
+		}
+	}
 }
 
-func TestReleaseOrderingMixedUp(t *testing.T) {
+func TestReleaseIsNotOlderThanDays(t *testing.T) {
 	ck, _ := NewChangeKind("")
 	hf := NewHeadingFactory(ck)
-	h, _ := hf.newRelease("[1.2.4] - 2020-04-15")
-	r1, _ := h.(Release)
-	//
-	h, _ = hf.newRelease("[1.2.3] - 2020-04-16")
-	r2, _ := h.(Release)
+	today := time.Date(2020, 4, 16, 0, 0, 0, 0, time.UTC)
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-09", Position{})
+	r := h.(Release)
 
 	assertions := require.New(t)
-	assertions.Error(r2.IsNewerThan(r1))
-	assertions.Error(r1.IsNewerThan(r2))
+	assertions.NoError(r.IsNotOlderThanDays(today, 7))
+	assertions.EqualError(r.IsNotOlderThanDays(today, 6), "release \"[1.2.3] - 2020-04-09\" should be dated at most 6 days before 2020-04-16")
+	assertions.EqualError(r.IsNotOlderThanDays(today, 0), "release \"[1.2.3] - 2020-04-09\" should be dated 2020-04-16")
 }
 
-func TestNextRelease(t *testing.T) {
+func TestNormalizeVersion(t *testing.T) {
+	for _, name := range []string{"1.4.0", "v1.4.0", " v1.4.0 "} {
+		version, err := NormalizeVersion(name)
+		require.NoError(t, err, name)
+		require.Equal(t, "1.4.0", version, name)
+	}
+	_, err := NormalizeVersion("release-1.4.0")
+	require.Error(t, err)
+}
+
+func TestReleaseYankedReason(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
 	testcases := []struct {
-		semverIdentifier semverConstants.Identifier
-		expected         semver.Version
+		title, reason, replacement string
 	}{
-		{semverConstants.Major, semver.Version{Major: 1, Minor: 0, Patch: 0}},
-		{semverConstants.Minor, semver.Version{Major: 0, Minor: 1, Patch: 0}},
-		{semverConstants.Patch, semver.Version{Major: 0, Minor: 0, Patch: 1}},
-		{semverConstants.Prerelease, semver.Version{}},
-		{semverConstants.Build, semver.Version{}},
+		{"[1.2.3] - 2020-04-15 [YANKED] reason: data loss, use 1.2.4", "data loss", "1.2.4"},
+		{"[1.2.3] - 2020-04-15 [YANKED] Reason: data loss; use v1.3.0-rc.1.", "data loss;", "1.3.0-rc.1"},
+		{"[1.2.3] - 2020-04-15 [YANKED] reason: use 2.0.0", "", "2.0.0"},
+		{"[1.2.3] - 2020-04-15 [YANKED] reason: misuse of the API", "misuse of the API", ""},
 	}
 	for _, testcase := range testcases {
-		t.Run(testcase.expected.String(), func(t *testing.T) {
-			r := &Release{}
-			require.Equal(t, testcase.expected, r.NextRelease(testcase.semverIdentifier))
+		t.Run(testcase.title, func(t *testing.T) {
+			h, err := hf.newRelease(testcase.title, Position{})
+			require.NoError(t, err)
+			r, _ := h.(Release)
+			require.True(t, r.HasBeenYanked())
+			require.Empty(t, r.Label())
+			require.Equal(t, testcase.reason, r.YankedReason())
+			require.Equal(t, testcase.replacement, r.Replacement())
 		})
 	}
 }
 
-func TestSubexp(t *testing.T) {
-	require.Equal(t, 1, subexp([]string { "group1", "group2" }, "group2") )
+func TestReleaseYankedLabel(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-15 [YANKED] Espelho", Position{})
+	r, _ := h.(Release)
+
+	assertions := require.New(t)
+	assertions.Equal("Espelho", r.Label())
+	assertions.Empty(r.YankedReason())
+	assertions.Empty(r.Replacement())
+	assertions.False(r.HasNewerReplacement())
 }
 
-func TestSubexpPanic(t *testing.T) {
-	require.PanicsWithValue(t, "Group `groupNotPresent` missing from regular expression", func() { subexp([]string { "group1", "group2" }, "groupNotPresent") } )
+func TestReleaseNotYankedReasonIsLabel(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	h, _ := hf.newRelease("[1.2.3] - 2020-04-15 reason: data loss", Position{})
+	r, _ := h.(Release)
+
+	assertions := require.New(t)
+	assertions.Equal("reason: data loss", r.Label())
+	assertions.Empty(r.YankedReason())
+}
+
+func TestReleaseYankedReplacementVersionShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	_, err := hf.newRelease("[1.2.3] - 2020-04-15 [YANKED] reason: data loss, use 1.02", Position{})
+	require.Error(t, err)
+}
+
+func TestReleaseHasNewerReplacement(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	for title, expected := range map[string]bool{
+		"[1.2.3] - 2020-04-15 [YANKED] reason: data loss, use 1.2.4": true,
+		"[1.2.3] - 2020-04-15 [YANKED] reason: data loss, use 1.2.3": false,
+		"[1.2.3] - 2020-04-15 [YANKED] reason: data loss, use 1.2.2": false,
+	} {
+		h, err := hf.newRelease(title, Position{})
+		require.NoError(t, err, title)
+		require.Equal(t, expected, h.(Release).HasNewerReplacement(), title)
+	}
+}
+
+func TestReleaseLinkedVersion(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	testcases := []struct {
+		title, version, url, label string
+	}{
+		{"[Unreleased](https://host/compare/v1.2.0...HEAD)", "", "https://host/compare/v1.2.0...HEAD", ""},
+		{"[1.2.0](https://host/compare/v1.1.0...v1.2.0) - 2024-01-02", "1.2.0", "https://host/compare/v1.1.0...v1.2.0", ""},
+		{"[1.2.0](https://host/compare/v1.1.0...v1.2.0 \"Compare\") - 2024-01-02 Espelho", "1.2.0", "https://host/compare/v1.1.0...v1.2.0", "Espelho"},
+		{"[ 1.2.0 ]() - 2024-01-02", "1.2.0", "", ""},
+		{"[1.2.0] - 2024-01-02", "1.2.0", "", ""},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.title, func(t *testing.T) {
+			h, err := hf.newRelease(testcase.title, Position{})
+			require.NoError(t, err)
+			r, _ := h.(Release)
+			require.Equal(t, testcase.version, r.Version())
+			require.Equal(t, testcase.url, r.URL())
+			require.Equal(t, testcase.label, r.Label())
+		})
+	}
+}
+
+func TestReleaseLinkedVersionWithoutSeparatorShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	_, err := hf.newRelease("[1.2.0](https://host/compare/v1.1.0...v1.2.0)2024-01-02", Position{})
+	require.Error(t, err)
 }
@@ -0,0 +1,43 @@
+package changelog
+
+import "fmt"
+
+// Section is a level 2 heading that is not a release, like a legend or a contribution guide, before or after
+// the releases. It sits at the level of the releases and keeps its content as written in the source.
+type Section struct {
+	heading
+	content string
+}
+
+// NewSection returns the section with the given title, position and raw markdown content.
+func (h HeadingsFactory) NewSection(title string, position Position, content string) (Section, error) {
+	if title == "" {
+		return Section{}, fmt.Errorf("validation error: section cannot stay empty")
+	}
+	return Section{heading{title: title, kind: ReleaseHeading, position: position}, content}, nil
+}
+
+// Content returns the markdown content of the section, as written in the source.
+func (h Section) Content() string {
+	return h.content
+}
+
+func (h Section) DisplayTitle() string {
+	return h.Title()
+}
+
+func (h Section) Title() string {
+	return h.title
+}
+
+func (h Section) Kind() HeadingKind {
+	return h.kind
+}
+
+func (h Section) Position() Position {
+	return h.position
+}
+
+func (h Section) String() string {
+	return asPath(h.title)
+}
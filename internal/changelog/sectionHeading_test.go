@@ -0,0 +1,25 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSection(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	h, err := hf.NewSection("Legend", Position{Line: 3, Column: 4}, "- *Added* for new features.")
+	require.NoError(t, err)
+	requireHeadingInterface(t, "Legend", h)
+	require.Equal(t, ReleaseHeading, h.Kind())
+	require.Equal(t, Position{Line: 3, Column: 4}, h.Position())
+	require.Equal(t, "- *Added* for new features.", h.Content())
+}
+
+func TestEmptySectionShouldFail(t *testing.T) {
+	ck, _ := NewChangeKind("")
+	hf := NewHeadingFactory(ck)
+	_, err := hf.NewSection("", Position{}, "")
+	require.Error(t, err)
+}
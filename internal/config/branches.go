@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/semver"
+)
+
+// A BranchPolicy holds the restrictions of a configuration file on the changes of the branches
+// whose name matches a pattern, like "release/*".
+type BranchPolicy struct {
+	// MaxIncrement is the largest version increment the changes may require, one of: major|minor|patch.
+	MaxIncrement string `json:"maxIncrement"`
+	// Forbid lists the change kinds forbidden on the branch.
+	Forbid []string `json:"forbid"`
+}
+
+// A ChangePolicy restricts the changes of the first release with changes of the changelog, usually the
+// [Unreleased], like on a maintenance branch where a major release is never acceptable.
+// The zero value accepts any change.
+type ChangePolicy struct {
+	maxIncrement semver.Identifier
+	forbidden    map[string]bool
+}
+
+// SetMaxIncrement caps the version increment the changes may require, one of: major|minor|patch.
+// The policy keeps the strictest of the caps.
+func (p *ChangePolicy) SetMaxIncrement(name string) error {
+	increment, err := semver.NewIdentifier(name)
+	if err != nil || increment > semver.Patch {
+		return fmt.Errorf("%q is not a valid increment, expected one of: major|minor|patch", name)
+	}
+	if increment > p.maxIncrement {
+		p.maxIncrement = increment
+	}
+	return nil
+}
+
+// Forbid forbids a change kind.
+func (p *ChangePolicy) Forbid(kind string) error {
+	kind = strings.TrimSpace(kind)
+	if kind == "" {
+		return fmt.Errorf("empty change kind in the forbidden changes")
+	}
+	if p.forbidden == nil {
+		p.forbidden = make(map[string]bool)
+	}
+	p.forbidden[kind] = true
+	return nil
+}
+
+// Parse forbids the change kinds listed in a comma-separated list.
+func (p *ChangePolicy) Parse(list string) error {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	for _, kind := range strings.Split(list, ",") {
+		if err := p.Forbid(kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply adds the restrictions of a branch policy.
+func (p *ChangePolicy) Apply(policy BranchPolicy) error {
+	if policy.MaxIncrement != "" {
+		if err := p.SetMaxIncrement(policy.MaxIncrement); err != nil {
+			return err
+		}
+	}
+	for _, kind := range policy.Forbid {
+		if err := p.Forbid(kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check returns an error if a forbidden change kind is not one of the change kinds.
+func (p ChangePolicy) Check(changeKind *changelog.ChangeKind) error {
+	kinds := make([]string, 0, len(p.forbidden))
+	for kind := range p.forbidden {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		if !changeKind.Has(kind) {
+			return fmt.Errorf("unknown change kind %q in the forbidden changes", kind)
+		}
+	}
+	return nil
+}
+
+// MaxIncrement returns the largest version increment the changes may require; major accepts any change.
+func (p ChangePolicy) MaxIncrement() semver.Identifier {
+	return p.maxIncrement
+}
+
+// IsForbidden returns true if the change kind is forbidden.
+func (p ChangePolicy) IsForbidden(kind string) bool {
+	return p.forbidden[kind]
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/semver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangePolicyZeroValue(t *testing.T) {
+	var policy ChangePolicy
+	require.Equal(t, semver.Major, policy.MaxIncrement())
+	require.False(t, policy.IsForbidden("Added"))
+}
+
+func TestChangePolicyKeepsStrictestIncrement(t *testing.T) {
+	var policy ChangePolicy
+	require.NoError(t, policy.SetMaxIncrement("patch"))
+	require.NoError(t, policy.SetMaxIncrement("minor"))
+	require.Equal(t, semver.Patch, policy.MaxIncrement())
+}
+
+func TestChangePolicyIllegalIncrement(t *testing.T) {
+	var policy ChangePolicy
+	require.Error(t, policy.SetMaxIncrement("build"))
+	require.Error(t, policy.SetMaxIncrement("huge"))
+}
+
+func TestChangePolicyParse(t *testing.T) {
+	var policy ChangePolicy
+	require.NoError(t, policy.Parse("Added, Removed"))
+	require.True(t, policy.IsForbidden("Added"))
+	require.True(t, policy.IsForbidden("Removed"))
+	require.False(t, policy.IsForbidden("Fixed"))
+}
+
+func TestChangePolicyEmptyEntry(t *testing.T) {
+	var policy ChangePolicy
+	require.Error(t, policy.Parse("Added,,Removed"))
+}
+
+func TestChangePolicyCheck(t *testing.T) {
+	changeKind, _ := changelog.NewChangeKind("")
+	var policy ChangePolicy
+	require.NoError(t, policy.Parse("Added"))
+	require.NoError(t, policy.Check(changeKind))
+	require.NoError(t, policy.Forbid("Ajouté"))
+	require.Error(t, policy.Check(changeKind))
+}
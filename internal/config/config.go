@@ -2,14 +2,35 @@
 package config
 
 import (
+	"time"
+
 	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
 )
 
 // A Config struct has configurations for the Validator.
 type Config struct {
-	release    bool
-	listener   changelog.Listener
-	changeKind *changelog.ChangeKind
+	release          bool
+	allErrors        bool
+	warningsAsErrors bool
+	listener         changelog.Listener
+	changeKind       *changelog.ChangeKind
+	rules            rule.Settings
+	today            time.Time
+	checkReleaseAge  bool
+	maxReleaseAge    int
+	expectedVersion  string
+	expectedDate     string
+	acrossReleases   bool
+	strict           bool
+	allowedContent   ContentAllowList
+	descriptionRules changelog.DescriptionRules
+	kindDescriptions map[string]changelog.DescriptionRules
+	groups           bool
+	scopes           []string
+	sections         SectionTitles
+	emoji            EmojiPolicy
+	changePolicy     ChangePolicy
 }
 
 // NewConfig builds a new Config with all Options.
@@ -25,6 +46,74 @@ func (c Config) IsRelease() bool {
 	return c.release
 }
 
+func (c Config) IsAllErrors() bool {
+	return c.allErrors
+}
+
+func (c Config) IsWarningsAsErrors() bool {
+	return c.warningsAsErrors
+}
+
+// IsDuplicatesAcrossReleases returns true if duplicate change descriptions are searched across
+// the whole changelog instead of within each release.
+func (c Config) IsDuplicatesAcrossReleases() bool {
+	return c.acrossReleases
+}
+
+// Today returns the reference date of the validation, the current date unless configured.
+// The date is at midnight UTC, like the release dates.
+func (c Config) Today() time.Time {
+	if !c.today.IsZero() {
+		return c.today
+	}
+	year, month, day := time.Now().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// MaxReleaseAge returns true and the maximum age, in days, of the most recent release in release mode
+// if that age is checked.
+func (c Config) MaxReleaseAge() (bool, int) {
+	return c.checkReleaseAge, c.maxReleaseAge
+}
+
+// ExpectedVersion returns the version expected of the most recent release, if any.
+func (c Config) ExpectedVersion() string {
+	return c.expectedVersion
+}
+
+// ExpectedDate returns the date, as YYYY-MM-DD, expected of the most recent release, if any.
+func (c Config) ExpectedDate() string {
+	return c.expectedDate
+}
+
+// Strict returns true and the content accepted besides the change descriptions if the validation
+// rejects any other content in the releases.
+func (c Config) Strict() (bool, ContentAllowList) {
+	return c.strict, c.allowedContent
+}
+
+// Groups returns true and the scopes accepted as group headings, any scope if none, if level-4 headings
+// group the change descriptions of a change.
+func (c Config) Groups() (bool, []string) {
+	return c.groups, c.scopes
+}
+
+// Sections returns the titles of the level 2 headings that are sections, before or after the releases,
+// rather than releases.
+func (c Config) Sections() SectionTitles {
+	return c.sections
+}
+
+// Emoji returns whether the change headings start with the emoji of their change kind.
+func (c Config) Emoji() EmojiPolicy {
+	return c.emoji
+}
+
+// ChangePolicy returns the restrictions on the changes of the first release of the changelog.
+func (c Config) ChangePolicy() ChangePolicy {
+	return c.changePolicy
+}
+
 func (c Config) Listeners() (bool, changelog.Listener) {
 	return c.listener != nil, c.listener
 }
@@ -32,3 +121,31 @@ func (c Config) Listeners() (bool, changelog.Listener) {
 func (c Config) ChangeKind() *changelog.ChangeKind {
 	return c.changeKind
 }
+
+// DescriptionRules returns the rules constraining the change descriptions of a change kind: the rules for all
+// the change kinds, overridden by those of the change map, overridden by those configured for the change kind.
+func (c Config) DescriptionRules(kind string) changelog.DescriptionRules {
+	rules := c.descriptionRules
+	if c.changeKind != nil {
+		if kindRules, ok := c.changeKind.DescriptionRules(kind); ok {
+			rules = rules.Merge(kindRules)
+		}
+	}
+	if kindRules, ok := c.kindDescriptions[kind]; ok {
+		rules = rules.Merge(kindRules)
+	}
+	return rules
+}
+
+// Rules returns the severity of the rules. A change map defining the order of its change kinds
+// turns the change-order rule, a warning for the Keep a Changelog order, into an error, unless its severity is set.
+func (c Config) Rules() rule.Settings {
+	if _, ok := c.rules[rule.ChangeOrder]; ok || c.changeKind == nil || !c.changeKind.IsOrdered() {
+		return c.rules
+	}
+	rules := rule.Settings{rule.ChangeOrder: rule.Error}
+	for r, severity := range c.rules {
+		rules[r] = severity
+	}
+	return rules
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDescriptionRules(t *testing.T) {
+	changeKind, err := changelog.NewChangeKind(writeFile(t, `[
+		{"name":"Added", "increment":"minor"},
+		{"name":"Fixed", "increment":"patch", "descriptions": {"require": "#\\d+"}},
+		{"name":"Security", "increment":"patch", "descriptions": {"require": "#\\d+"}}
+		]`))
+	require.NoError(t, err)
+	c := NewConfig(
+		WithChangeKind(changeKind),
+		WithDescriptionRules(changelog.DescriptionRules{MaxLength: 20}, map[string]changelog.DescriptionRules{"Security": {MinLength: 18}}))
+
+	require.NoError(t, c.DescriptionRules("Added").Validate("Parse the headings"))
+	require.Error(t, c.DescriptionRules("Added").Validate("Parse the emoji headings"))
+	require.NoError(t, c.DescriptionRules("Fixed").Validate("Fix the parser #12"))
+	require.Error(t, c.DescriptionRules("Fixed").Validate("Fix the parser"))
+	require.Error(t, c.DescriptionRules("Security").Validate("Patch parser #12"))
+	require.NoError(t, c.DescriptionRules("Security").Validate("Patch the parser #12"))
+}
+
+func TestConfigRulesChangeOrder(t *testing.T) {
+	ordered, err := changelog.NewChangeKind(writeFile(t, `[
+		{"name":"Added", "increment":"minor", "order": 2},
+		{"name":"Fixed", "increment":"patch", "order": 1}
+		]`))
+	require.NoError(t, err)
+	unordered, err := changelog.NewChangeKind("")
+	require.NoError(t, err)
+
+	require.Equal(t, rule.Warning, NewConfig(WithChangeKind(unordered), WithRules(rule.Settings{})).Rules().Severity(rule.ChangeOrder))
+	require.Equal(t, rule.Off, NewConfig(WithChangeKind(unordered), WithRules(rule.Settings{rule.ChangeOrder: rule.Off})).Rules().Severity(rule.ChangeOrder))
+	require.Equal(t, rule.Error, NewConfig(WithChangeKind(ordered), WithRules(rule.Settings{})).Rules().Severity(rule.ChangeOrder))
+	require.Equal(t, rule.Warning, NewConfig(WithChangeKind(ordered), WithRules(rule.Settings{rule.ChangeOrder: rule.Warning})).Rules().Severity(rule.ChangeOrder))
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scopes of the content accepted in strict mode: the release, before its first change heading, and the change.
+const (
+	ReleaseScope = "release"
+	ChangeScope  = "change"
+)
+
+// contentKinds lists the kinds of block content that can be accepted in strict mode.
+var contentKinds = []string{"blockquote", "code", "html", "list", "paragraph", "thematic-break"}
+
+// A ContentAllowList lists the block content, other than change descriptions, accepted in strict mode
+// as scope:kind entries, like "release:paragraph" for a release summary.
+type ContentAllowList map[string]bool
+
+// Add accepts the content of an entry, like "release:paragraph", or "code" for both scopes.
+func (a ContentAllowList) Add(entry string) error {
+	scope, kind, ok := strings.Cut(strings.TrimSpace(entry), ":")
+	scopes := []string{ReleaseScope, ChangeScope}
+	if !ok {
+		scope, kind = "", scope
+	} else if scope != ReleaseScope && scope != ChangeScope {
+		return fmt.Errorf("unknown content scope %q in %q, expected one of: %v|%v", scope, entry, ReleaseScope, ChangeScope)
+	} else {
+		scopes = []string{scope}
+	}
+	if !isContentKind(kind) {
+		return fmt.Errorf("unknown content kind %q in %q, expected one of: %v", kind, entry, strings.Join(contentKinds, "|"))
+	}
+	for _, scope := range scopes {
+		a[scope+":"+kind] = true
+	}
+	return nil
+}
+
+// Parse accepts the content listed in a comma-separated list of entries.
+func (a ContentAllowList) Parse(list string) error {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	for _, entry := range strings.Split(list, ",") {
+		if err := a.Add(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsAllowed returns true if the kind of content is accepted in the scope.
+func (a ContentAllowList) IsAllowed(scope string, kind string) bool {
+	return a[scope+":"+kind]
+}
+
+func isContentKind(kind string) bool {
+	for _, k := range contentKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
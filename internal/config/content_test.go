@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentAllowListParse(t *testing.T) {
+	allowed := make(ContentAllowList)
+	require.NoError(t, allowed.Parse("release:paragraph, code"))
+	require.True(t, allowed.IsAllowed(ReleaseScope, "paragraph"))
+	require.False(t, allowed.IsAllowed(ChangeScope, "paragraph"))
+	require.True(t, allowed.IsAllowed(ReleaseScope, "code"))
+	require.True(t, allowed.IsAllowed(ChangeScope, "code"))
+	require.False(t, allowed.IsAllowed(ChangeScope, "blockquote"))
+}
+
+func TestContentAllowListParseEmpty(t *testing.T) {
+	allowed := make(ContentAllowList)
+	require.NoError(t, allowed.Parse(" "))
+	require.Empty(t, allowed)
+}
+
+func TestContentAllowListUnknownScope(t *testing.T) {
+	require.Error(t, make(ContentAllowList).Add("introduction:paragraph"))
+}
+
+func TestContentAllowListUnknownKind(t *testing.T) {
+	require.Error(t, make(ContentAllowList).Add("release:table"))
+}
@@ -0,0 +1,39 @@
+package config
+
+import "fmt"
+
+// EmojiPolicy tells whether the change headings of the changelog start with the emoji of their change kind.
+type EmojiPolicy int
+
+const (
+	EmojiOptional EmojiPolicy = iota
+	EmojiRequired
+	EmojiForbidden
+)
+
+// NewEmojiPolicy returns the emoji policy with the given name.
+func NewEmojiPolicy(name string) (EmojiPolicy, error) {
+	switch name {
+	case "optional":
+		return EmojiOptional, nil
+	case "required":
+		return EmojiRequired, nil
+	case "forbidden":
+		return EmojiForbidden, nil
+	default:
+		return EmojiOptional, fmt.Errorf("%q is not a valid emoji policy", name)
+	}
+}
+
+func (p EmojiPolicy) String() string {
+	switch p {
+	case EmojiOptional:
+		return "optional"
+	case EmojiRequired:
+		return "required"
+	case EmojiForbidden:
+		return "forbidden"
+	default:
+		panic(fmt.Sprintf("\"%d\" not defined", p))
+	}
+}
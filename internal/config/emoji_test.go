@@ -0,0 +1,20 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmojiPolicy(t *testing.T) {
+	for _, name := range []string{"optional", "required", "forbidden"} {
+		policy, err := NewEmojiPolicy(name)
+		require.NoError(t, err)
+		require.Equal(t, name, policy.String())
+	}
+}
+
+func TestEmojiPolicyUnknown(t *testing.T) {
+	_, err := NewEmojiPolicy("mandatory")
+	require.Error(t, err)
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
+)
+
+// A File holds the settings read from a configuration file.
+type File struct {
+	// Rules maps a rule identifier or name to its severity.
+	Rules map[string]string `json:"rules"`
+	// Allow lists the block content accepted in strict mode, like "release:paragraph".
+	Allow []string `json:"allow"`
+	// Descriptions constrains the change descriptions of every change kind.
+	Descriptions *changelog.DescriptionRules `json:"descriptions"`
+	// KindDescriptions constrains the change descriptions of a change kind, overriding Descriptions and the change map.
+	KindDescriptions map[string]*changelog.DescriptionRules `json:"kindDescriptions"`
+	// Groups accepts level-4 headings grouping the change descriptions of a change.
+	Groups *Groups `json:"groups"`
+	// Sections lists the titles of the level 2 headings that are sections rather than releases,
+	// an exact title or a regular expression between slashes.
+	Sections []string `json:"sections"`
+	// Emoji tells whether the change headings start with the emoji of their change kind, one of: optional|required|forbidden.
+	Emoji string `json:"emoji"`
+	// Branches maps a branch name pattern, like "release/*", to the restrictions on the changes of that branch.
+	Branches map[string]BranchPolicy `json:"branches"`
+}
+
+// Groups holds the settings of the level-4 group headings.
+type Groups struct {
+	// Scopes lists the titles accepted for the group headings, like "api" or "cli"; any title if empty.
+	Scopes []string `json:"scopes"`
+}
+
+// LoadFile loads a configuration File.
+func LoadFile(fileName string) (*File, error) {
+	file, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{}
+	if err := json.Unmarshal(file, f); err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", fileName, err)
+	}
+	return f, nil
+}
+
+// ApplyRules copies the rule severities into the settings.
+func (f *File) ApplyRules(settings rule.Settings) error {
+	for key, severity := range f.Rules {
+		if err := settings.Set(key, severity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyAllow copies the content accepted in strict mode into the allow-list.
+func (f *File) ApplyAllow(allowed ContentAllowList) error {
+	for _, entry := range f.Allow {
+		if err := allowed.Add(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplySections copies the titles of the sections into the section titles.
+func (f *File) ApplySections(sections *SectionTitles) error {
+	for _, entry := range f.Sections {
+		if err := sections.Add(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyBranches adds to the policy the restrictions of every branch pattern matching the branch.
+// No pattern applies when the branch is not given.
+func (f *File) ApplyBranches(branch string, policy *ChangePolicy) error {
+	if branch == "" {
+		return nil
+	}
+	patterns := make([]string, 0, len(f.Branches))
+	for pattern := range f.Branches {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, branch)
+		if err != nil {
+			return fmt.Errorf("error matching branch pattern %q: %w", pattern, err)
+		}
+		if !matched {
+			continue
+		}
+		if err := policy.Apply(f.Branches[pattern]); err != nil {
+			return fmt.Errorf("error in branch pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// DescriptionRules returns the rules constraining the change descriptions, and their overrides by change kind.
+// It is an error for an override to name a change kind that is not defined.
+func (f *File) DescriptionRules(changeKind *changelog.ChangeKind) (changelog.DescriptionRules, map[string]changelog.DescriptionRules, error) {
+	var rules changelog.DescriptionRules
+	if f.Descriptions != nil {
+		rules = *f.Descriptions
+	}
+	byKind := make(map[string]changelog.DescriptionRules)
+	for kind, kindRules := range f.KindDescriptions {
+		if !changeKind.Has(kind) {
+			return changelog.DescriptionRules{}, nil, fmt.Errorf("unknown change kind %q in the description rules", kind)
+		}
+		if kindRules != nil {
+			byKind[kind] = *kindRules
+		}
+	}
+	return rules, byKind, nil
+}
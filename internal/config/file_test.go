@@ -0,0 +1,162 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
+	"github.com/denisa/clq/internal/semver"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, content string) string {
+	fileName := filepath.Join(t.TempDir(), "clq.json")
+	require.NoError(t, os.WriteFile(fileName, []byte(content), 0o600))
+	return fileName
+}
+
+func TestLoadFile(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"rules": {"CLQ010": "off", "date-order": "warning"}}`))
+	require.NoError(t, err)
+
+	settings := make(rule.Settings)
+	require.NoError(t, f.ApplyRules(settings))
+	require.Equal(t, rule.Off, settings.Severity(rule.VersionIncrement))
+	require.Equal(t, rule.Warning, settings.Severity(rule.DateOrder))
+}
+
+func TestLoadFileMissing(t *testing.T) {
+	_, err := LoadFile(filepath.Join(t.TempDir(), "clq.json"))
+	require.Error(t, err)
+}
+
+func TestLoadFileIllegalJson(t *testing.T) {
+	_, err := LoadFile(writeFile(t, `{"rules": ["CLQ010"]}`))
+	require.Error(t, err)
+}
+
+func TestApplyRulesUnknownRule(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"rules": {"CLQ999": "off"}}`))
+	require.NoError(t, err)
+	require.Error(t, f.ApplyRules(make(rule.Settings)))
+}
+
+func TestApplyAllow(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"allow": ["release:paragraph", "html"]}`))
+	require.NoError(t, err)
+
+	allowed := make(ContentAllowList)
+	require.NoError(t, f.ApplyAllow(allowed))
+	require.True(t, allowed.IsAllowed(ReleaseScope, "paragraph"))
+	require.True(t, allowed.IsAllowed(ChangeScope, "html"))
+	require.False(t, allowed.IsAllowed(ChangeScope, "paragraph"))
+}
+
+func TestApplyAllowUnknownKind(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"allow": ["table"]}`))
+	require.NoError(t, err)
+	require.Error(t, f.ApplyAllow(make(ContentAllowList)))
+}
+
+func TestDescriptionRules(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"descriptions": {"capitalized": true}, "kindDescriptions": {"Security": {"require": "CVE-\\d+"}}}`))
+	require.NoError(t, err)
+
+	changeKind, _ := changelog.NewChangeKind("")
+	rules, byKind, err := f.DescriptionRules(changeKind)
+	require.NoError(t, err)
+	require.Error(t, rules.Validate("fix a bug"))
+	require.Len(t, byKind, 1)
+	require.Error(t, byKind["Security"].Validate("Patch the parser"))
+}
+
+func TestDescriptionRulesUnknownChangeKind(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"kindDescriptions": {"Securty": {"require": "CVE-\\d+"}}}`))
+	require.NoError(t, err)
+
+	changeKind, _ := changelog.NewChangeKind("")
+	_, _, err = f.DescriptionRules(changeKind)
+	require.Error(t, err)
+}
+
+func TestLoadFileIllegalDescriptionRules(t *testing.T) {
+	_, err := LoadFile(writeFile(t, `{"descriptions": {"forbid": "[a-"}}`))
+	require.Error(t, err)
+}
+
+func TestLoadFileGroups(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"groups": {"scopes": ["api", "cli"]}}`))
+	require.NoError(t, err)
+	require.NotNil(t, f.Groups)
+	require.Equal(t, []string{"api", "cli"}, f.Groups.Scopes)
+}
+
+func TestLoadFileWithoutGroups(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{}`))
+	require.NoError(t, err)
+	require.Nil(t, f.Groups)
+}
+
+func TestApplySections(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"sections": ["Legend", "/^Older/"]}`))
+	require.NoError(t, err)
+
+	var sections SectionTitles
+	require.NoError(t, f.ApplySections(&sections))
+	require.True(t, sections.Matches("Legend"))
+	require.True(t, sections.Matches("Older releases"))
+}
+
+func TestApplySectionsIllegalRegularExpression(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"sections": ["/[/"]}`))
+	require.NoError(t, err)
+	require.Error(t, f.ApplySections(&SectionTitles{}))
+}
+
+func TestLoadFileEmoji(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"emoji": "required"}`))
+	require.NoError(t, err)
+	require.Equal(t, "required", f.Emoji)
+}
+
+func TestApplyBranches(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"branches": {"release/*": {"maxIncrement": "minor", "forbid": ["Removed"]}, "release/1.*": {"maxIncrement": "patch"}}}`))
+	require.NoError(t, err)
+
+	var policy ChangePolicy
+	require.NoError(t, f.ApplyBranches("release/2.x", &policy))
+	require.Equal(t, semver.Minor, policy.MaxIncrement())
+	require.True(t, policy.IsForbidden("Removed"))
+
+	policy = ChangePolicy{}
+	require.NoError(t, f.ApplyBranches("release/1.x", &policy))
+	require.Equal(t, semver.Patch, policy.MaxIncrement())
+
+	policy = ChangePolicy{}
+	require.NoError(t, f.ApplyBranches("main", &policy))
+	require.Equal(t, semver.Major, policy.MaxIncrement())
+	require.False(t, policy.IsForbidden("Removed"))
+}
+
+func TestApplyBranchesWithoutBranch(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"branches": {"*": {"maxIncrement": "patch"}, "release/[": {"maxIncrement": "minor"}}}`))
+	require.NoError(t, err)
+
+	var policy ChangePolicy
+	require.NoError(t, f.ApplyBranches("", &policy))
+	require.Equal(t, semver.Major, policy.MaxIncrement())
+}
+
+func TestApplyBranchesIllegalIncrement(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"branches": {"release/*": {"maxIncrement": "build"}}}`))
+	require.NoError(t, err)
+	require.Error(t, f.ApplyBranches("release/2.x", &ChangePolicy{}))
+}
+
+func TestApplyBranchesIllegalPattern(t *testing.T) {
+	f, err := LoadFile(writeFile(t, `{"branches": {"release/[": {"maxIncrement": "minor"}}}`))
+	require.NoError(t, err)
+	require.Error(t, f.ApplyBranches("release/2.x", &ChangePolicy{}))
+}
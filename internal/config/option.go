@@ -1,7 +1,10 @@
 package config
 
 import (
+	"time"
+
 	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
 )
 
 // An Option interface sets options for the Validator.
@@ -59,3 +62,248 @@ func WithRelease(release bool) interface {
 } {
 	return &withRelease{release}
 }
+
+// ------------- AllErrors -------------
+type withAllErrors struct {
+	value bool
+}
+
+func (o *withAllErrors) SetValidationOption(c *Config) {
+	c.allErrors = o.value
+}
+
+// WithAllErrors is a functional option that allow you to let the Validator
+// report all the errors instead of stopping at the first one.
+func WithAllErrors(allErrors bool) interface {
+	Option
+} {
+	return &withAllErrors{allErrors}
+}
+
+// ------------- Rules -------------
+type withRules struct {
+	value rule.Settings
+}
+
+func (o *withRules) SetValidationOption(c *Config) {
+	c.rules = o.value
+}
+
+// WithRules is a functional option that allow you to turn off, or downgrade to
+// warnings, the rules of the Validator.
+func WithRules(rules rule.Settings) interface {
+	Option
+} {
+	return &withRules{rules}
+}
+
+// ------------- WarningsAsErrors -------------
+type withWarningsAsErrors struct {
+	value bool
+}
+
+func (o *withWarningsAsErrors) SetValidationOption(c *Config) {
+	c.warningsAsErrors = o.value
+}
+
+// WithWarningsAsErrors is a functional option that allow you to let the Validator
+// fail on warnings.
+func WithWarningsAsErrors(warningsAsErrors bool) interface {
+	Option
+} {
+	return &withWarningsAsErrors{warningsAsErrors}
+}
+
+// ------------- Today -------------
+type withToday struct {
+	value time.Time
+}
+
+func (o *withToday) SetValidationOption(c *Config) {
+	c.today = o.value
+}
+
+// WithToday is a functional option that allow you to set the reference date
+// against which the Validator checks the release dates.
+func WithToday(today time.Time) interface {
+	Option
+} {
+	return &withToday{today}
+}
+
+// ------------- MaxReleaseAge -------------
+type withMaxReleaseAge struct {
+	value int
+}
+
+func (o *withMaxReleaseAge) SetValidationOption(c *Config) {
+	c.checkReleaseAge = o.value >= 0
+	c.maxReleaseAge = o.value
+}
+
+// WithMaxReleaseAge is a functional option that allow you to set the maximum age,
+// in days, of the most recent release in release mode. A negative age is not checked.
+func WithMaxReleaseAge(days int) interface {
+	Option
+} {
+	return &withMaxReleaseAge{days}
+}
+
+// ------------- ExpectedVersion -------------
+type withExpectedVersion struct {
+	value string
+}
+
+func (o *withExpectedVersion) SetValidationOption(c *Config) {
+	c.expectedVersion = o.value
+}
+
+// WithExpectedVersion is a functional option that allow you to set the version
+// expected of the most recent release.
+func WithExpectedVersion(version string) interface {
+	Option
+} {
+	return &withExpectedVersion{version}
+}
+
+// ------------- ExpectedDate -------------
+type withExpectedDate struct {
+	value string
+}
+
+func (o *withExpectedDate) SetValidationOption(c *Config) {
+	c.expectedDate = o.value
+}
+
+// WithExpectedDate is a functional option that allow you to set the date, as
+// YYYY-MM-DD, expected of the most recent release.
+func WithExpectedDate(date string) interface {
+	Option
+} {
+	return &withExpectedDate{date}
+}
+
+// ------------- DuplicatesAcrossReleases -------------
+type withDuplicatesAcrossReleases struct {
+	value bool
+}
+
+func (o *withDuplicatesAcrossReleases) SetValidationOption(c *Config) {
+	c.acrossReleases = o.value
+}
+
+// WithDuplicatesAcrossReleases is a functional option that allow you to let the
+// Validator search duplicate change descriptions across all the releases.
+func WithDuplicatesAcrossReleases(acrossReleases bool) interface {
+	Option
+} {
+	return &withDuplicatesAcrossReleases{acrossReleases}
+}
+
+// ------------- Strict -------------
+type withStrict struct {
+	value   bool
+	allowed ContentAllowList
+}
+
+func (o *withStrict) SetValidationOption(c *Config) {
+	c.strict = o.value
+	c.allowedContent = o.allowed
+}
+
+// WithStrict is a functional option that allow you to let the Validator reject
+// the content of the releases other than change descriptions, except the allowed content.
+func WithStrict(strict bool, allowed ContentAllowList) interface {
+	Option
+} {
+	return &withStrict{strict, allowed}
+}
+
+// ------------- DescriptionRules -------------
+type withDescriptionRules struct {
+	value  changelog.DescriptionRules
+	byKind map[string]changelog.DescriptionRules
+}
+
+func (o *withDescriptionRules) SetValidationOption(c *Config) {
+	c.descriptionRules = o.value
+	c.kindDescriptions = o.byKind
+}
+
+// WithDescriptionRules is a functional option that allow you to set the rules
+// constraining the change descriptions, and their overrides by change kind.
+func WithDescriptionRules(rules changelog.DescriptionRules, byKind map[string]changelog.DescriptionRules) interface {
+	Option
+} {
+	return &withDescriptionRules{rules, byKind}
+}
+
+// ------------- Groups -------------
+type withGroups struct {
+	value  bool
+	scopes []string
+}
+
+func (o *withGroups) SetValidationOption(c *Config) {
+	c.groups = o.value
+	c.scopes = o.scopes
+}
+
+// WithGroups is a functional option that allow you to let the Validator accept level-4 headings
+// grouping the change descriptions of a change, restricted to the scopes if any.
+func WithGroups(groups bool, scopes []string) interface {
+	Option
+} {
+	return &withGroups{groups, scopes}
+}
+
+// ------------- Sections -------------
+type withSections struct {
+	value SectionTitles
+}
+
+func (o *withSections) SetValidationOption(c *Config) {
+	c.sections = o.value
+}
+
+// WithSections is a functional option that allow you to let the Validator accept
+// level 2 headings that are sections rather than releases, before or after the releases.
+func WithSections(sections SectionTitles) interface {
+	Option
+} {
+	return &withSections{sections}
+}
+
+// ------------- Emoji -------------
+type withEmoji struct {
+	value EmojiPolicy
+}
+
+func (o *withEmoji) SetValidationOption(c *Config) {
+	c.emoji = o.value
+}
+
+// WithEmoji is a functional option that allow you to require, or forbid, the emoji of their change kind
+// at the start of the change headings.
+func WithEmoji(policy EmojiPolicy) interface {
+	Option
+} {
+	return &withEmoji{policy}
+}
+
+// ------------- ChangePolicy -------------
+type withChangePolicy struct {
+	value ChangePolicy
+}
+
+func (o *withChangePolicy) SetValidationOption(c *Config) {
+	c.changePolicy = o.value
+}
+
+// WithChangePolicy is a functional option that allow you to cap the version increment, and forbid change kinds,
+// in the first release of the changelog.
+func WithChangePolicy(policy ChangePolicy) interface {
+	Option
+} {
+	return &withChangePolicy{policy}
+}
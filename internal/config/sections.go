@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SectionTitles matches the titles of the level 2 headings that are sections rather than releases,
+// like "Legend", by exact title or by a regular expression written between slashes, like "/^Older releases/".
+type SectionTitles struct {
+	titles   map[string]bool
+	patterns []*regexp.Regexp
+}
+
+// Add matches the titles of an entry, an exact title or a regular expression between slashes.
+func (s *SectionTitles) Add(entry string) error {
+	entry = strings.TrimSpace(entry)
+	switch {
+	case entry == "":
+		return fmt.Errorf("section title cannot stay empty")
+	case len(entry) > 2 && strings.HasPrefix(entry, "/") && strings.HasSuffix(entry, "/"):
+		re, err := regexp.Compile(entry[1 : len(entry)-1])
+		if err != nil {
+			return fmt.Errorf("error parsing section regular expression %q: %w", entry, err)
+		}
+		s.patterns = append(s.patterns, re)
+	default:
+		if s.titles == nil {
+			s.titles = make(map[string]bool)
+		}
+		s.titles[entry] = true
+	}
+	return nil
+}
+
+// Parse matches the titles listed in a comma-separated list of entries.
+func (s *SectionTitles) Parse(list string) error {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	for _, entry := range strings.Split(list, ",") {
+		if err := s.Add(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the title is the one of a section.
+func (s SectionTitles) Matches(title string) bool {
+	title = strings.TrimSpace(title)
+	if s.titles[title] {
+		return true
+	}
+	for _, re := range s.patterns {
+		if re.MatchString(title) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSectionTitlesParse(t *testing.T) {
+	var sections SectionTitles
+	require.NoError(t, sections.Parse("Legend, /^Older releases/"))
+	require.True(t, sections.Matches("Legend"))
+	require.True(t, sections.Matches(" Legend "))
+	require.False(t, sections.Matches("Legends"))
+	require.True(t, sections.Matches("Older releases (before 2019)"))
+	require.False(t, sections.Matches("[1.0.0] - 2020-02-01"))
+}
+
+func TestSectionTitlesParseEmpty(t *testing.T) {
+	var sections SectionTitles
+	require.NoError(t, sections.Parse(" "))
+	require.False(t, sections.Matches(""))
+}
+
+func TestSectionTitlesEmptyEntry(t *testing.T) {
+	var sections SectionTitles
+	require.Error(t, sections.Parse("Legend,,Contributing"))
+}
+
+func TestSectionTitlesIllegalRegularExpression(t *testing.T) {
+	var sections SectionTitles
+	require.Error(t, sections.Add("/(/"))
+}
@@ -0,0 +1,59 @@
+package diagnostic
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// a checkstyleFormat renders the diagnostics as a Checkstyle XML report.
+type checkstyleFormat struct {
+	collector
+}
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr,omitempty"`
+}
+
+func (f *checkstyleFormat) Render(w io.Writer) error {
+	report := checkstyleReport{Version: "4.3"}
+	for _, file := range f.files {
+		cf := checkstyleFile{Name: file}
+		for _, d := range f.diagnostics[file] {
+			ce := checkstyleError{Line: d.Line, Column: d.Column, Severity: d.Severity.String(), Message: d.Message}
+			if d.Rule.ID != "" {
+				ce.Source = "clq." + d.Rule.ID
+			}
+			cf.Errors = append(cf.Errors, ce)
+		}
+		report.Files = append(report.Files, cf)
+	}
+	return writeXML(w, report)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
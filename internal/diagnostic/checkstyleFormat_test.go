@@ -0,0 +1,19 @@
+package diagnostic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckstyleFormat(t *testing.T) {
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="valid.md"></file>
+  <file name="invalid.md">
+    <error line="12" column="4" severity="error" message="release &#34;[1.2.0] - 2020-02-29&#34; should have version 1.3.0 because of &#34;Added&#34;" source="clq.CLQ010"></error>
+    <error severity="error" message="no such file or directory"></error>
+  </file>
+</checkstyle>
+`, render(t, "checkstyle"))
+}
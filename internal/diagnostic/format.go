@@ -0,0 +1,85 @@
+// Package diagnostic provides for a plugin mechanism through which validation failures are
+// reported in formats understood by editors, code-scanning and test-report tooling.
+package diagnostic
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/denisa/clq/internal/rule"
+)
+
+// A Diagnostic is a single validation failure reported against a document.
+// A zero Line means that the position is unknown, a zero Rule that the failure
+// is not a rule violation, for example an unreadable document.
+// A non-zero RelatedLine locates another place of the document involved in the failure,
+// like the first occurrence of a duplicate, described by the RelatedMessage.
+type Diagnostic struct {
+	File           string
+	Line           int
+	Column         int
+	Rule           rule.Rule
+	Severity       rule.Severity
+	Message        string
+	RelatedLine    int
+	RelatedColumn  int
+	RelatedMessage string
+}
+
+// Format exposes to the rest of the application the plugin mechanism
+// through which multiple diagnostics formats are supported.
+type Format interface {
+	// Document registers a processed document, whether it has diagnostics or not.
+	Document(file string)
+	// Add collects a diagnostic for a registered document.
+	Add(d Diagnostic)
+	// Render writes all the collected diagnostics.
+	Render(w io.Writer) error
+}
+
+func NewFormat(formatName string) (Format, error) {
+	switch formatName {
+	case "checkstyle":
+		return &checkstyleFormat{}, nil
+	case "github":
+		return &githubFormat{}, nil
+	case "json":
+		return &jsonFormat{}, nil
+	case "junit":
+		return &junitFormat{}, nil
+	case "sarif":
+		return &sarifFormat{}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized diagnostics format %q. Supported format: \"checkstyle\", \"github\", \"json\", \"junit\", \"sarif\"", formatName)
+	}
+}
+
+// collector keeps the diagnostics of every document, in the order they were processed.
+type collector struct {
+	files       []string
+	diagnostics map[string][]Diagnostic
+}
+
+func (c *collector) Document(file string) {
+	if c.diagnostics == nil {
+		c.diagnostics = make(map[string][]Diagnostic)
+	}
+	if _, ok := c.diagnostics[file]; !ok {
+		c.files = append(c.files, file)
+		c.diagnostics[file] = nil
+	}
+}
+
+func (c *collector) Add(d Diagnostic) {
+	c.Document(d.File)
+	c.diagnostics[d.File] = append(c.diagnostics[d.File], d)
+}
+
+// all returns every diagnostic, grouped by document.
+func (c *collector) all() []Diagnostic {
+	var result []Diagnostic
+	for _, file := range c.files {
+		result = append(result, c.diagnostics[file]...)
+	}
+	return result
+}
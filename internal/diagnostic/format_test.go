@@ -0,0 +1,66 @@
+package diagnostic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denisa/clq/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsupportedDiagnosticsFormat(t *testing.T) {
+	_, err := NewFormat("yaml")
+	require.Error(t, err)
+}
+
+func TestDocumentRegisteredOnce(t *testing.T) {
+	c := &collector{}
+	c.Document("CHANGELOG.md")
+	c.Add(Diagnostic{File: "CHANGELOG.md", Message: "foo"})
+	c.Document("CHANGELOG.md")
+	require.Equal(t, []string{"CHANGELOG.md"}, c.files)
+	require.Len(t, c.all(), 1)
+}
+
+func TestAddRegistersDocument(t *testing.T) {
+	c := &collector{}
+	c.Add(Diagnostic{File: "CHANGELOG.md", Message: "foo"})
+	require.Equal(t, []string{"CHANGELOG.md"}, c.files)
+}
+
+// render renders two documents, the first one valid, the second one with two diagnostics.
+func render(t *testing.T, format string) string {
+	f, err := NewFormat(format)
+	require.NoError(t, err)
+	f.Document("valid.md")
+	f.Document("invalid.md")
+	f.Add(Diagnostic{File: "invalid.md", Line: 12, Column: 4, Rule: rule.VersionIncrement, Severity: rule.Error, Message: `release "[1.2.0] - 2020-02-29" should have version 1.3.0 because of "Added"`})
+	f.Add(Diagnostic{File: "invalid.md", Severity: rule.Error, Message: "no such file or directory"})
+
+	var buf strings.Builder
+	require.NoError(t, f.Render(&buf))
+	return buf.String()
+}
+
+// renderRelated renders a diagnostic with a related location.
+func renderRelated(t *testing.T, format string) string {
+	f, err := NewFormat(format)
+	require.NoError(t, err)
+	f.Add(Diagnostic{File: "invalid.md", Line: 12, Column: 3, Rule: rule.DescriptionDuplicate, Severity: rule.Error, Message: `change description "foo" is a duplicate`,
+		RelatedLine: 10, RelatedColumn: 3, RelatedMessage: "first occurrence of the change description"})
+
+	var buf strings.Builder
+	require.NoError(t, f.Render(&buf))
+	return buf.String()
+}
+
+// renderEmpty renders a single valid document.
+func renderEmpty(t *testing.T, format string) string {
+	f, err := NewFormat(format)
+	require.NoError(t, err)
+	f.Document("valid.md")
+
+	var buf strings.Builder
+	require.NoError(t, f.Render(&buf))
+	return buf.String()
+}
@@ -0,0 +1,39 @@
+package diagnostic
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// a githubFormat renders the diagnostics as GitHub workflow commands, displayed by GitHub as annotations.
+type githubFormat struct {
+	collector
+}
+
+func (f *githubFormat) Render(w io.Writer) error {
+	for _, d := range f.all() {
+		var properties strings.Builder
+		properties.WriteString("file=")
+		properties.WriteString(githubEscapeProperty(d.File))
+		if d.Line > 0 {
+			_, _ = fmt.Fprintf(&properties, ",line=%d,col=%d", d.Line, d.Column)
+		}
+		if d.Rule.ID != "" {
+			properties.WriteString(",title=")
+			properties.WriteString(githubEscapeProperty(d.Rule.String()))
+		}
+		if _, err := fmt.Fprintf(w, "::%v %v::%v\n", d.Severity, properties.String(), githubEscapeData(d.Message)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func githubEscapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func githubEscapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
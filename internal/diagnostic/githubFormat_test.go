@@ -0,0 +1,21 @@
+package diagnostic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubFormat(t *testing.T) {
+	require.Equal(t, "::error file=invalid.md,line=12,col=4,title=CLQ010 version-increment::release \"[1.2.0] - 2020-02-29\" should have version 1.3.0 because of \"Added\"\n"+
+		"::error file=invalid.md::no such file or directory\n", render(t, "github"))
+}
+
+func TestGithubFormatEmpty(t *testing.T) {
+	require.Empty(t, renderEmpty(t, "github"))
+}
+
+func TestGithubEscape(t *testing.T) {
+	require.Equal(t, "100%25%0Adone: a, b", githubEscapeData("100%\ndone: a, b"))
+	require.Equal(t, "100%25%0Adone%3A a%2C b", githubEscapeProperty("100%\ndone: a, b"))
+}
@@ -0,0 +1,50 @@
+package diagnostic
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// a jsonFormat renders the diagnostics as a json array.
+type jsonFormat struct {
+	collector
+}
+
+type jsonDiagnostic struct {
+	File     string       `json:"file"`
+	Line     int          `json:"line,omitempty"`
+	Column   int          `json:"column,omitempty"`
+	Rule     string       `json:"rule,omitempty"`
+	RuleName string       `json:"ruleName,omitempty"`
+	Severity string       `json:"severity"`
+	Message  string       `json:"message"`
+	Related  *jsonRelated `json:"related,omitempty"`
+}
+
+type jsonRelated struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (f *jsonFormat) Render(w io.Writer) error {
+	result := make([]jsonDiagnostic, 0)
+	for _, d := range f.all() {
+		diagnostic := jsonDiagnostic{
+			File:     d.File,
+			Line:     d.Line,
+			Column:   d.Column,
+			Rule:     d.Rule.ID,
+			RuleName: d.Rule.Name,
+			Severity: d.Severity.String(),
+			Message:  d.Message,
+		}
+		if d.RelatedLine > 0 {
+			diagnostic.Related = &jsonRelated{Line: d.RelatedLine, Column: d.RelatedColumn, Message: d.RelatedMessage}
+		}
+		result = append(result, diagnostic)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(result)
+}
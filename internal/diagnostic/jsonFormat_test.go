@@ -0,0 +1,25 @@
+package diagnostic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonFormat(t *testing.T) {
+	require.JSONEq(t, `[
+		{"file":"invalid.md", "line":12, "column":4, "rule":"CLQ010", "ruleName":"version-increment", "severity":"error", "message":"release \"[1.2.0] - 2020-02-29\" should have version 1.3.0 because of \"Added\""},
+		{"file":"invalid.md", "severity":"error", "message":"no such file or directory"}
+		]`, render(t, "json"))
+}
+
+func TestJsonFormatRelated(t *testing.T) {
+	require.JSONEq(t, `[
+		{"file":"invalid.md", "line":12, "column":3, "rule":"CLQ028", "ruleName":"description-duplicate", "severity":"error", "message":"change description \"foo\" is a duplicate",
+		 "related":{"line":10, "column":3, "message":"first occurrence of the change description"}}
+		]`, renderRelated(t, "json"))
+}
+
+func TestJsonFormatEmpty(t *testing.T) {
+	require.Equal(t, "[]\n", renderEmpty(t, "json"))
+}
@@ -0,0 +1,81 @@
+package diagnostic
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/denisa/clq/internal/rule"
+)
+
+// a junitFormat renders the diagnostics as a JUnit XML report, with one test case per document.
+type junitFormat struct {
+	collector
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func (f *junitFormat) Render(w io.Writer) error {
+	suite := junitTestSuite{Name: "clq"}
+	for _, file := range f.files {
+		testCase := junitTestCase{Name: file, ClassName: "clq"}
+		var failures, warnings strings.Builder
+		var first *Diagnostic
+		for i, d := range f.diagnostics[file] {
+			if d.Severity != rule.Error {
+				_, _ = fmt.Fprintln(&warnings, junitLine(d))
+				continue
+			}
+			if first == nil {
+				first = &f.diagnostics[file][i]
+			}
+			_, _ = fmt.Fprintln(&failures, junitLine(d))
+		}
+		if first != nil {
+			testCase.Failure = &junitFailure{Message: first.Message, Type: first.Rule.ID, Text: failures.String()}
+			suite.Failures++
+		}
+		testCase.SystemOut = warnings.String()
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	return writeXML(w, junitTestSuites{Suites: []junitTestSuite{suite}})
+}
+
+func junitLine(d Diagnostic) string {
+	var buf strings.Builder
+	buf.WriteString(d.File)
+	if d.Line > 0 {
+		_, _ = fmt.Fprintf(&buf, ":%d:%d", d.Line, d.Column)
+	}
+	buf.WriteString(": ")
+	if d.Rule.ID != "" {
+		_, _ = fmt.Fprintf(&buf, "%v: ", d.Rule)
+	}
+	buf.WriteString(d.Message)
+	return buf.String()
+}
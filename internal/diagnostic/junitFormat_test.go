@@ -0,0 +1,32 @@
+package diagnostic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denisa/clq/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJunitFormat(t *testing.T) {
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="clq" tests="2" failures="1">
+    <testcase name="valid.md" classname="clq"></testcase>
+    <testcase name="invalid.md" classname="clq">
+      <failure message="release &#34;[1.2.0] - 2020-02-29&#34; should have version 1.3.0 because of &#34;Added&#34;" type="CLQ010">invalid.md:12:4: CLQ010 version-increment: release &#34;[1.2.0] - 2020-02-29&#34; should have version 1.3.0 because of &#34;Added&#34;&#xA;invalid.md: no such file or directory&#xA;</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, render(t, "junit"))
+}
+
+func TestJunitFormatWarningIsNotAFailure(t *testing.T) {
+	f, _ := NewFormat("junit")
+	f.Add(Diagnostic{File: "CHANGELOG.md", Line: 3, Column: 4, Rule: rule.VersionIncrement, Severity: rule.Warning, Message: "foo"})
+
+	var buf strings.Builder
+	require.NoError(t, f.Render(&buf))
+	require.Contains(t, buf.String(), `failures="0"`)
+	require.Contains(t, buf.String(), "<system-out>CHANGELOG.md:3:4: CLQ010 version-increment: foo&#xA;</system-out>")
+}
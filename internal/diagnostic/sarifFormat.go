@@ -0,0 +1,109 @@
+package diagnostic
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/denisa/clq/internal/rule"
+)
+
+// a sarifFormat renders the diagnostics as a SARIF 2.1.0 log.
+type sarifFormat struct {
+	collector
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID           string          `json:"ruleId,omitempty"`
+	Level            string          `json:"level"`
+	Message          sarifMessage    `json:"message"`
+	Locations        []sarifLocation `json:"locations"`
+	RelatedLocations []sarifLocation `json:"relatedLocations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	Message          *sarifMessage         `json:"message,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+func (f *sarifFormat) Render(w io.Writer) error {
+	driver := sarifDriver{Name: "clq", InformationURI: "https://github.com/denisa/clq"}
+	for _, r := range rule.Rules() {
+		driver.Rules = append(driver.Rules, sarifRule{ID: r.ID, Name: r.Name})
+	}
+
+	results := make([]sarifResult, 0)
+	for _, d := range f.all() {
+		location := sarifLocation{PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: d.File}}}
+		if d.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{StartLine: d.Line, StartColumn: d.Column}
+		}
+		result := sarifResult{
+			RuleID:    d.Rule.ID,
+			Level:     d.Severity.String(),
+			Message:   sarifMessage{d.Message},
+			Locations: []sarifLocation{location},
+		}
+		if d.RelatedLine > 0 {
+			result.RelatedLocations = []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: d.File},
+					Region:           &sarifRegion{StartLine: d.RelatedLine, StartColumn: d.RelatedColumn},
+				},
+				Message: &sarifMessage{d.RelatedMessage},
+			}}
+		}
+		results = append(results, result)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{driver}, Results: results}},
+	})
+}
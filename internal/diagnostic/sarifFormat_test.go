@@ -0,0 +1,53 @@
+package diagnostic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSarifFormat(t *testing.T) {
+	var log sarifLog
+	require.NoError(t, json.Unmarshal([]byte(render(t, "sarif")), &log))
+
+	assertions := require.New(t)
+	assertions.Equal("2.1.0", log.Version)
+	assertions.Len(log.Runs, 1)
+	assertions.Equal("clq", log.Runs[0].Tool.Driver.Name)
+	assertions.NotEmpty(log.Runs[0].Tool.Driver.Rules)
+	assertions.Len(log.Runs[0].Results, 2)
+	{
+		result := log.Runs[0].Results[0]
+		assertions.Equal("CLQ010", result.RuleID)
+		assertions.Equal("error", result.Level)
+		assertions.Equal("invalid.md", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		assertions.Equal(&sarifRegion{StartLine: 12, StartColumn: 4}, result.Locations[0].PhysicalLocation.Region)
+	}
+	{
+		result := log.Runs[0].Results[1]
+		assertions.Empty(result.RuleID)
+		assertions.Nil(result.Locations[0].PhysicalLocation.Region)
+	}
+}
+
+func TestSarifFormatRelated(t *testing.T) {
+	var log sarifLog
+	require.NoError(t, json.Unmarshal([]byte(renderRelated(t, "sarif")), &log))
+
+	assertions := require.New(t)
+	assertions.Len(log.Runs[0].Results, 1)
+	result := log.Runs[0].Results[0]
+	assertions.Empty(result.Locations[0].Message)
+	assertions.Len(result.RelatedLocations, 1)
+	assertions.Equal("invalid.md", result.RelatedLocations[0].PhysicalLocation.ArtifactLocation.URI)
+	assertions.Equal(&sarifRegion{StartLine: 10, StartColumn: 3}, result.RelatedLocations[0].PhysicalLocation.Region)
+	assertions.Equal(&sarifMessage{"first occurrence of the change description"}, result.RelatedLocations[0].Message)
+}
+
+func TestSarifFormatEmpty(t *testing.T) {
+	var log sarifLog
+	require.NoError(t, json.Unmarshal([]byte(renderEmpty(t, "sarif")), &log))
+	require.NotNil(t, log.Runs[0].Results)
+	require.Empty(t, log.Runs[0].Results)
+}
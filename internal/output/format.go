@@ -22,6 +22,11 @@ type Format interface {
 	Set(value string)
 	SetField(name string, value string)
 	Array(name string)
+	// SetItem sets the value to a change description, with its nested change descriptions.
+	SetItem(item changelog.ChangeItem)
+	// SetItems sets the value to the nested change descriptions of a change description,
+	// each one a result of the collection.
+	SetItems(items []changelog.ChangeItem)
 }
 
 func NewFormat(formatName string) (Format, error) {
@@ -50,12 +50,42 @@ func formatChangeHeading(format string) string {
 	return of.Result()
 }
 
+func formatGroupHeading(format string) string {
+	of, _ := NewFormat(format)
+	title := "api"
+	h := newHeading(changelog.GroupHeading, title)
+	of.Open(h)
+	of.SetField("title", title)
+	of.Close(h)
+	return of.Result()
+}
+
+func formatSection(format string) string {
+	of, _ := NewFormat(format)
+	ck, _ := changelog.NewChangeKind("")
+	hf := changelog.NewHeadingFactory(ck)
+	h, _ := hf.NewSection("Legend", changelog.Position{}, "- *Added* for new features.")
+	of.Open(h)
+	of.SetField("title", h.Title())
+	of.SetField("content", h.Content())
+	of.Close(h)
+	return of.Result()
+}
+
 func formatChangeDescription(format string) string {
 	of, _ := NewFormat(format)
-	title := "foo"
-	h := newHeading(changelog.ChangeDescription, title)
+	h := newChangeItem("foo")
+	of.Open(h)
+	of.SetItem(h)
+	of.Close(h)
+	return of.Result()
+}
+
+func formatChangeDescriptionText(format string) string {
+	of, _ := NewFormat(format)
+	h := newChangeItem("foo")
 	of.Open(h)
-	of.Set(title)
+	of.Set(h.Title())
 	of.Close(h)
 	return of.Result()
 }
@@ -66,27 +96,55 @@ func formatLoneArray(format string) string {
 	h := newHeading(changelog.ChangeHeading, "Added")
 	of.Open(h)
 	of.Array("changes")
-	{
-		h := newHeading(changelog.ChangeDescription, "ignored")
+	for _, h := range []changelog.ChangeItem{newChangeItem("foo"), newChangeItem("bar")} {
 		of.Open(h)
-		of.Set("foo")
+		of.SetItem(h)
 		of.Close(h)
 	}
-	{
-		h := newHeading(changelog.ChangeDescription, "ignored")
+	of.Close(h)
+	return of.Result()
+}
+
+func formatChangeDescriptionWithItems(format string) string {
+	of, _ := NewFormat(format)
+	h := newChangeItem("foo", newChangeItem("bar", newChangeItem("baz")), newChangeItem("qux"))
+	of.Open(h)
+	of.SetItem(h)
+	of.Close(h)
+	return of.Result()
+}
+
+func formatItems(format string) string {
+	of, _ := NewFormat(format)
+	of.SetCollection()
+	for _, h := range []changelog.ChangeItem{
+		newChangeItem("foo", newChangeItem("bar", newChangeItem("baz")), newChangeItem("qux")),
+		newChangeItem("fred"),
+		newChangeItem("waldo", newChangeItem("thud")),
+	} {
 		of.Open(h)
-		of.Set("bar")
+		of.SetItems(h.Items())
 		of.Close(h)
 	}
-	of.Close(h)
 	return of.Result()
 }
 
+func newChangeItem(text string, items ...changelog.ChangeItem) changelog.ChangeItem {
+	ck, _ := changelog.NewChangeKind("")
+	hf := changelog.NewHeadingFactory(ck)
+
+	h, err := hf.NewChangeItem(text, changelog.Position{}, "", items)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
 func newHeading(kind changelog.HeadingKind, text string) changelog.Heading {
 	ck, _ := changelog.NewChangeKind("")
 	hf := changelog.NewHeadingFactory(ck)
 
-	h, err := hf.NewHeading(kind, text)
+	h, err := hf.NewHeading(kind, text, changelog.Position{})
 	if err != nil {
 		panic(err)
 	}
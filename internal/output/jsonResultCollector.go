@@ -13,9 +13,10 @@ type jsonResultCollector struct {
 }
 
 type jsonResult struct {
-	value interface{}
-	name  string
-	kind  changelog.HeadingKind
+	value  interface{}
+	name   string
+	kind   changelog.HeadingKind
+	spread bool
 }
 
 func (rc *jsonResultCollector) Result() string {
@@ -58,10 +59,15 @@ func (rc *jsonResultCollector) Close(_ changelog.Heading) {
 		}
 		return
 	}
+	spread := rc.results[i].spread
 	rc.results = rc.results[:i]
 	i--
 
 	if _, ok := (rc.results[i].value).([]interface{}); ok && rc.collection {
+		if spread {
+			rc.results[i].value = append((rc.results[i].value).([]interface{}), newValue.([]interface{})...)
+			return
+		}
 		rc.results[i].value = append((rc.results[i].value).([]interface{}), newValue)
 		return
 	}
@@ -97,3 +103,23 @@ func (rc *jsonResultCollector) Array(name string) {
 	result[name] = make([]interface{}, 0)
 	rc.results[i].name = name
 }
+
+func (rc *jsonResultCollector) SetItem(item changelog.ChangeItem) {
+	rc.results[len(rc.results)-1].value = item.DisplayTitle()
+}
+
+func (rc *jsonResultCollector) SetItems(items []changelog.ChangeItem) {
+	i := len(rc.results) - 1
+	rc.results[i].value = itemValues(items)
+	rc.results[i].spread = true
+}
+
+// itemValues returns the texts of the change descriptions, whose nested change descriptions
+// are only queried through their items.
+func itemValues(items []changelog.ChangeItem) []interface{} {
+	values := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		values = append(values, item.DisplayTitle())
+	}
+	return values
+}
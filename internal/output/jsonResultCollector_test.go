@@ -22,10 +22,30 @@ func TestJsonChangeHeading(t *testing.T) {
 	require.Equal(t, "{\"title\":\"Added\"}", formatChangeHeading("json"))
 }
 
+func TestJsonGroupHeading(t *testing.T) {
+	require.Equal(t, "{\"title\":\"api\"}", formatGroupHeading("json"))
+}
+
+func TestJsonSection(t *testing.T) {
+	require.JSONEq(t, "{\"title\":\"Legend\",\"content\":\"- *Added* for new features.\"}", formatSection("json"))
+}
+
 func TestJsonChangeDescription(t *testing.T) {
 	require.Equal(t, "foo", formatChangeDescription("json"))
 }
 
+func TestJsonChangeDescriptionText(t *testing.T) {
+	require.Equal(t, "foo", formatChangeDescriptionText("json"))
+}
+
 func TestJsonLoneArray(t *testing.T) {
 	require.Equal(t, "{\"changes\":[\"foo\",\"bar\"]}", formatLoneArray("json"))
 }
+
+func TestJsonChangeDescriptionWithItems(t *testing.T) {
+	require.Equal(t, "foo", formatChangeDescriptionWithItems("json"))
+}
+
+func TestJsonItems(t *testing.T) {
+	require.JSONEq(t, `["bar","qux","thud"]`, formatItems("json"))
+}
@@ -25,7 +25,7 @@ func lineStart(heading changelog.HeadingKind) string {
 	case changelog.ChangeDescription:
 		return "- "
 	default:
-		return ("###"[:(heading + 1)]) + " "
+		return strings.Repeat("#", int(heading)+1) + " "
 	}
 }
 
@@ -36,20 +36,46 @@ func (rc *mdResultCollector) SetCollection() {
 }
 
 func (rc *mdResultCollector) Set(value string) {
-	rc.result.WriteString(rc.prefix)
 	rc.result.WriteString(value)
 	rc.result.WriteString("\n")
 	rc.prefix = ""
 }
 
 func (rc *mdResultCollector) SetField(name string, value string) {
-	if name == "title" {
+	switch name {
+	case "title":
 		rc.result.WriteString(rc.prefix)
 		rc.result.WriteString(value)
 		rc.result.WriteString("\n")
 		rc.prefix = ""
+	case "content":
+		if value != "" {
+			rc.result.WriteString(value)
+			rc.result.WriteString("\n")
+		}
 	}
 }
 
 func (rc *mdResultCollector) Array(_ string) {
 }
+
+func (rc *mdResultCollector) SetItem(item changelog.ChangeItem) {
+	rc.writeItems([]changelog.ChangeItem{item}, "")
+	rc.prefix = ""
+}
+
+func (rc *mdResultCollector) SetItems(items []changelog.ChangeItem) {
+	rc.writeItems(items, "")
+	rc.prefix = ""
+}
+
+// writeItems writes the change descriptions as a list, indented at their nesting level.
+func (rc *mdResultCollector) writeItems(items []changelog.ChangeItem, indent string) {
+	for _, item := range items {
+		rc.result.WriteString(indent)
+		rc.result.WriteString("- ")
+		rc.result.WriteString(item.DisplayTitle())
+		rc.result.WriteString("\n")
+		rc.writeItems(item.Items(), indent+"  ")
+	}
+}
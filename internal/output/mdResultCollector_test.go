@@ -22,10 +22,30 @@ func TestMdChangeHeading(t *testing.T) {
 	require.Equal(t, "### Added", formatChangeHeading("md"))
 }
 
+func TestMdGroupHeading(t *testing.T) {
+	require.Equal(t, "#### api", formatGroupHeading("md"))
+}
+
+func TestMdSection(t *testing.T) {
+	require.Equal(t, "## Legend\n- *Added* for new features.", formatSection("md"))
+}
+
 func TestMdChangeDescription(t *testing.T) {
 	require.Equal(t, "- foo", formatChangeDescription("md"))
 }
 
+func TestMdChangeDescriptionText(t *testing.T) {
+	require.Equal(t, "foo", formatChangeDescriptionText("md"))
+}
+
 func TestMdLoneArray(t *testing.T) {
 	require.Equal(t, "- foo\n- bar", formatLoneArray("md"))
 }
+
+func TestMdChangeDescriptionWithItems(t *testing.T) {
+	require.Equal(t, "- foo\n  - bar\n    - baz\n  - qux", formatChangeDescriptionWithItems("md"))
+}
+
+func TestMdItems(t *testing.T) {
+	require.Equal(t, "- bar\n  - baz\n- qux\n- thud", formatItems("md"))
+}
@@ -7,25 +7,65 @@ import (
 	"github.com/denisa/clq/internal/output"
 )
 
-func changeItemQueryFactory(selector string, _ bool, queryElements []string) (Query, parsedElement, error) {
-	if selector != "" {
-		return nil, parsedElement{}, fmt.Errorf("query change selector %q not yet supported", selector)
+func changeItemQueryFactory(selector string, isRecursive bool, queryElements []string) (Query, parsedElement, error) {
+	selection, err := parseSelection("description", selector, nil, nil)
+	if err != nil {
+		return nil, parsedElement{}, err
 	}
-	if len(queryElements) > 0 {
-		return nil, parsedElement{}, fmt.Errorf("query attribute selector %v not yet supported", queryElements)
-	}
-	queryMe := &changeItemQuery{}
-	queryMe.collection = true
-	queryMe.exit = func(of output.Format, h changelog.Heading) {
-		if h, ok := h.(changelog.ChangeItem); ok {
-			of.Set(h.DisplayTitle())
+	if len(queryElements) == 0 {
+		queryMe := &changeItemQuery{selection: selection}
+		// the descriptions of a recursive query are an array of their change, or group, not a collection of results.
+		queryMe.collection = selectsMany(selector) && !isRecursive
+		queryMe.exit = func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.ChangeItem); ok {
+				of.SetItem(h)
+			}
 		}
+		return queryMe, parsedElement{}, nil
+	}
+
+	pe, projection, err := changeItemParserConfiguration().parseElement(queryElements)
+	if err != nil {
+		return nil, parsedElement{}, err
+	}
+	if pe.selector != "" {
+		return nil, parsedElement{}, fmt.Errorf("query items selector %q not yet supported", pe.selector)
 	}
-	return queryMe, parsedElement{}, nil
+	if len(queryElements) > 1 {
+		return nil, parsedElement{}, fmt.Errorf("no further query element allowed after %q", queryElements[0])
+	}
+	return &changeItemQuery{projection, selection}, pe, nil
+}
+
+func changeItemParserConfiguration() parserConfiguration {
+	return parserConfiguration{"description", expectedElements{
+		"group": {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.ChangeItem); ok {
+				of.Set(h.Group())
+			}
+		}, nil, nil},
+		"items": {false, nil, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.ChangeItem); ok {
+				of.SetItems(h.Items())
+			}
+		}, nil},
+		"markdown": {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.ChangeItem); ok {
+				of.Set(h.Markdown())
+			}
+		}, nil, nil},
+		"position": {true, func(of output.Format, h changelog.Heading) {
+			of.Set(h.Position().String())
+		}, nil, nil},
+		"text": {true, func(of output.Format, h changelog.Heading) {
+			of.Set(h.Title())
+		}, nil, nil},
+	}}
 }
 
 type changeItemQuery struct {
 	projections
+	selection
 }
 
 func (q *changeItemQuery) isCollection() bool {
@@ -38,14 +78,14 @@ func (q *changeItemQuery) Accept(heading changelog.Heading) bool {
 }
 
 func (q *changeItemQuery) Enter(heading changelog.Heading) (bool, project) {
-	if !q.Accept(heading) {
+	if !q.Accept(heading) || !q.selects(heading) {
 		return false, nil
 	}
 	return true, q.enter
 }
 
 func (q *changeItemQuery) Exit(heading changelog.Heading) (bool, project) {
-	if !q.Accept(heading) {
+	if !q.Accept(heading) || !q.selected {
 		return false, nil
 	}
 	return true, q.exit
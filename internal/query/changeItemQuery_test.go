@@ -27,6 +27,21 @@ func TestChangeItemQueryUnsupportedAttribute(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestChangeItemQueryItemsAsScalar(t *testing.T) {
+	_, err := newQueryEngine("releases[2].changes[].descriptions[].items", "json")
+	require.Error(t, err)
+}
+
+func TestChangeItemQueryUnsupportedItemsSelector(t *testing.T) {
+	_, err := newQueryEngine("releases[2].changes[].descriptions[].items[0]", "json")
+	require.Error(t, err)
+}
+
+func TestChangeItemQueryUnsupportedItemsAttribute(t *testing.T) {
+	_, err := newQueryEngine("releases[2].changes[].descriptions[].items[].items[]", "json")
+	require.Error(t, err)
+}
+
 func TestChangeItemQueryAgainstRelease(t *testing.T) {
 	assertions := require.New(t)
 
@@ -74,7 +89,147 @@ func TestChangeItemQueryCollection(t *testing.T) {
 		assertions.False(query.isCollection())
 	}
 	{
-		query := &changeItemQuery{projections{collection: true}}
+		query := &changeItemQuery{projections: projections{collection: true}}
 		assertions.True(query.isCollection())
 	}
 }
+
+func TestChangeItemQueryItems(t *testing.T) {
+	assertions := require.New(t)
+	ck, _ := changelog.NewChangeKind("")
+	hf := changelog.NewHeadingFactory(ck)
+	baz, _ := hf.NewChangeItem("baz", changelog.Position{}, "", nil)
+	bar, _ := hf.NewChangeItem("bar", changelog.Position{}, "", []changelog.ChangeItem{baz})
+	qux, _ := hf.NewChangeItem("qux", changelog.Position{}, "", nil)
+	foo, _ := hf.NewChangeItem("foo", changelog.Position{}, "", []changelog.ChangeItem{bar, qux})
+	result, err := apply("releases[0].changes[].descriptions[].items[]", []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Removed"),
+		foo,
+		newHeading(changelog.ChangeDescription, "waldo"),
+	})
+	assertions.NoError(err)
+	assertions.JSONEq(`["bar","qux"]`, result)
+
+	result, err = apply("releases[0].changes[].descriptions[]", []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Removed"),
+		foo,
+		newHeading(changelog.ChangeDescription, "waldo"),
+	})
+	assertions.NoError(err)
+	assertions.JSONEq(`["foo","waldo"]`, result)
+}
+
+func indexedDescriptionHeadings() []changelog.Heading {
+	return []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Added"),
+		newHeading(changelog.ChangeDescription, "foo"),
+		newHeading(changelog.ChangeDescription, "bar"),
+		newHeading(changelog.ChangeDescription, "baz"),
+		newHeading(changelog.ChangeHeading, "Fixed"),
+		newHeading(changelog.ChangeDescription, "waldo"),
+		newHeading(changelog.ChangeDescription, "fred"),
+	}
+}
+
+func TestChangeItemQueryIndices(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[0].changes[0].descriptions[0]":    "foo",
+		"releases[0].changes[0].descriptions[-1]":   "baz",
+		"releases[0].changes[0].descriptions[5]":    "",
+		"releases[0].changes[].descriptions[0]":     "[\"foo\", \"waldo\"]",
+		"releases[0].changes[].descriptions[-1]":    "[\"baz\", \"fred\"]",
+		"releases[0].changes[0].descriptions[1:]":   "[\"bar\", \"baz\"]",
+		"releases[0].changes[1].descriptions[-1:]":  "[\"fred\"]",
+		"releases[0].changes[0].descriptions[0:0]":  "[]",
+		"releases[0].changes[-1].descriptions[:-1]": "[\"waldo\"]",
+	} {
+		result, err := apply(query, indexedDescriptionHeadings())
+		require.NoError(t, err, query)
+		if expected != "" && expected[0] == '[' {
+			require.JSONEq(t, expected, result, query)
+			continue
+		}
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func TestChangeItemQueryAttributes(t *testing.T) {
+	ck, _ := changelog.NewChangeKind("")
+	hf := changelog.NewHeadingFactory(ck)
+	foo, _ := hf.NewChangeItem("The -output option", changelog.Position{Line: 7, Column: 3}, "The `-output` option", nil)
+	bar, _ := hf.NewChangeItem("bar", changelog.Position{Line: 8, Column: 3}, "", nil)
+	headings := []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Added"),
+		foo,
+		bar,
+	}
+	for query, expected := range map[string]string{
+		"releases[0].changes[0].descriptions[0].text":      "The -output option",
+		"releases[0].changes[0].descriptions[0].markdown":  "The `-output` option",
+		"releases[0].changes[0].descriptions[-1].markdown": "bar",
+		"releases[0].changes[0].descriptions[-1].position": "8:3",
+		"releases[0].changes[0].descriptions[].position":   "[\"7:3\", \"8:3\"]",
+		"releases[0].changes[0].descriptions[1:].text":     "[\"bar\"]",
+	} {
+		result, err := apply(query, headings)
+		require.NoError(t, err, query)
+		if expected[0] == '[' {
+			require.JSONEq(t, expected, result, query)
+			continue
+		}
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func TestChangeItemQueryUnsupportedAttributes(t *testing.T) {
+	for _, query := range []string{
+		"releases[0].changes[0].descriptions[0].text[]",
+		"releases[0].changes[0].descriptions[0].text.size",
+		"releases[0].changes[0].descriptions[0].fabulator",
+	} {
+		_, err := newQueryEngine(query, "json")
+		require.Error(t, err, query)
+	}
+}
+
+func TestChangeItemQueryGroup(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[0].changes[0].descriptions[0].group": "",
+		"releases[0].changes[0].descriptions[1].group": "api",
+		"releases[0].changes[0].descriptions[].group":  `["", "api", "cli"]`,
+	} {
+		qe, err := newQueryEngine(query, "json")
+		require.NoError(t, err, query)
+		ck, _ := changelog.NewChangeKind("")
+		hf := changelog.NewHeadingFactory(ck)
+		c := changelog.NewChangelog(hf)
+		c.Listener(qe)
+		_, _ = c.Section(changelog.IntroductionHeading, "changelog", changelog.Position{})
+		_, _ = c.Section(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16", changelog.Position{})
+		_, _ = c.Section(changelog.ChangeHeading, "Fixed", changelog.Position{})
+		for _, description := range []struct{ group, text string }{{"", "foo"}, {"api", "bar"}, {"cli", "baz"}} {
+			if description.group != "" {
+				_, _ = c.Section(changelog.GroupHeading, description.group, changelog.Position{})
+			}
+			item, _ := hf.NewChangeItem(description.text, changelog.Position{}, "", nil)
+			require.NoError(t, c.Description(item))
+		}
+		c.Close()
+
+		result, err := qe.Result()
+		require.NoError(t, err, query)
+		if expected != "" && expected[0] == '[' {
+			require.JSONEq(t, expected, result, query)
+			continue
+		}
+		require.Equal(t, expected, result, query)
+	}
+}
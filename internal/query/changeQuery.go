@@ -9,16 +9,18 @@ import (
 
 const (
 	jsonNameDescriptions string = "descriptions"
+	jsonNameGroups       string = "groups"
 	jsonNameTitle        string = "title"
 )
 
 func changeQueryFactory(selector string, isRecursive bool, queryElements []string) (Query, parsedElement, error) {
-	if selector != "" {
-		return nil, parsedElement{}, fmt.Errorf("query change selector %q not yet supported", selector)
+	selection, err := changeSelection(selector)
+	if err != nil {
+		return nil, parsedElement{}, err
 	}
 
-	queryMe := &changeQuery{}
-	queryMe.collection = true
+	queryMe := &changeQuery{selection: selection}
+	queryMe.collection = selectsMany(selector)
 
 	parsedElement := parsedElement{}
 
@@ -47,12 +49,40 @@ func changeQueryFactory(selector string, isRecursive bool, queryElements []strin
 		return nil, parsedElement, err
 	}
 
-	return &changeQuery{projection}, parsedElement, nil
+	return &changeQuery{projections: projection, selection: selection}, parsedElement, nil
+}
+
+// changeSelection returns the selection of the changes of a release: all of them, the one at an index, a slice,
+// the ones matching a predicate, like `title=Security`, or the one of a change kind, like `Security`.
+func changeSelection(selector string) (selection, error) {
+	return parseSelection("change", selector, changeAttributes(), changeNamer)
+}
+
+// changeNamer selects the change of a change kind, named by its name or one of its aliases, with or without its emoji.
+func changeNamer(name string) (filter, error) {
+	return func(h changelog.Heading) bool {
+		change, ok := h.(changelog.Change)
+		return ok && change.IsNamed(name)
+	}, nil
+}
+
+// changeAttributes returns the attributes of a change a predicate can compare.
+// The title compares with the change kind, with or without its emoji.
+func changeAttributes() attributes {
+	return attributes{
+		jsonNameTitle: {textAttribute, func(h changelog.Heading) []string {
+			if h, ok := h.(changelog.Change); ok {
+				return []string{h.Title(), h.DisplayTitle()}
+			}
+			return nil
+		}},
+	}
 }
 
 func changeParserConfiguration() parserConfiguration {
 	return parserConfiguration{"change", expectedElements{
 		jsonNameDescriptions: {false, nil, nil, changeItemQueryFactory},
+		jsonNameGroups:       {false, nil, nil, groupQueryFactory},
 		jsonNameTitle: {true, func(of output.Format, h changelog.Heading) {
 			if h, ok := h.(changelog.Change); ok {
 				of.Set(h.DisplayTitle())
@@ -62,6 +92,8 @@ func changeParserConfiguration() parserConfiguration {
 
 type changeQuery struct {
 	projections
+	selection
+	changeKind *changelog.ChangeKind
 }
 
 func (q *changeQuery) isCollection() bool {
@@ -74,7 +106,7 @@ func (q *changeQuery) Accept(heading changelog.Heading) bool {
 }
 
 func (q *changeQuery) Enter(heading changelog.Heading) (bool, project) {
-	if !q.Accept(heading) {
+	if !q.Accept(heading) || !q.selects(heading) {
 		return false, nil
 	}
 	return true, q.enter
@@ -86,3 +118,11 @@ func (q *changeQuery) Exit(heading changelog.Heading) (bool, project) {
 	}
 	return true, q.exit
 }
+
+// unresolved returns an error if the query names a change kind the change map does not have, like `changes[Bogus]`.
+func (q *changeQuery) unresolved() error {
+	if q.named == "" || q.changeKind == nil || q.changeKind.Designates(q.named) {
+		return nil
+	}
+	return fmt.Errorf("query change %q is not a change kind", q.named)
+}
@@ -18,7 +18,7 @@ func TestChangeQueryAsScalar(t *testing.T) {
 }
 
 func TestChangeQueryUnsupportedSelector(t *testing.T) {
-	_, err := newQueryEngine("releases[2].changes[three]", "json")
+	_, err := newQueryEngine("releases[2].changes[1:b]", "json")
 	require.Error(t, err)
 }
 
@@ -141,7 +141,104 @@ func TestChangeQueryCollection(t *testing.T) {
 		assertions.False(query.isCollection())
 	}
 	{
-		query := &changeQuery{projections{collection: true}}
+		query := &changeQuery{projections: projections{collection: true}}
 		assertions.True(query.isCollection())
 	}
 }
+
+func TestChangeQueryPredicate(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[].changes[title=Fixed]/", []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Added"),
+		newHeading(changelog.ChangeDescription, "bar"),
+		newHeading(changelog.ChangeHeading, "Fixed"),
+		newHeading(changelog.ChangeDescription, "waldo"),
+		newHeading(changelog.ReleaseHeading, "[1.2.2] - 2020-05-15"),
+		newHeading(changelog.ChangeHeading, "Fixed"),
+		newHeading(changelog.ChangeDescription, "fred"),
+	})
+	assertions.NoError(err)
+	assertions.JSONEq("[{\"title\":\"Fixed\", \"descriptions\":[\"waldo\"]},{\"title\":\"Fixed\", \"descriptions\":[\"fred\"]}]", result)
+}
+
+func TestChangeQueryPredicateUnsupportedAttribute(t *testing.T) {
+	_, err := newQueryEngine("releases[0].changes[kind=Fixed]", "json")
+	require.Error(t, err)
+}
+
+func indexedChangeHeadings() []changelog.Heading {
+	return []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Added"),
+		newHeading(changelog.ChangeDescription, "bar"),
+		newHeading(changelog.ChangeHeading, "Deprecated"),
+		newHeading(changelog.ChangeDescription, "baz"),
+		newHeading(changelog.ChangeHeading, "Fixed"),
+		newHeading(changelog.ChangeDescription, "waldo"),
+		newHeading(changelog.ReleaseHeading, "[1.2.2] - 2020-05-15"),
+		newHeading(changelog.ChangeHeading, "Security"),
+		newHeading(changelog.ChangeDescription, "fred"),
+	}
+}
+
+func TestChangeQueryIndices(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[0].changes[1].title":   "Deprecated",
+		"releases[0].changes[-1].title":  "Fixed",
+		"releases[1].changes[0].title":   "Security",
+		"releases[1].changes[-1].title":  "Security",
+		"releases[0].changes[3].title":   "",
+		"releases[0].changes[1]":         "{\"title\":\"Deprecated\"}",
+		"releases[0].changes[1]/":        "{\"title\":\"Deprecated\", \"descriptions\":[\"baz\"]}",
+		"releases[].changes[0].title":    "[\"Added\", \"Security\"]",
+		"releases[].changes[-1].title":   "[\"Fixed\", \"Security\"]",
+		"releases[0].changes[1:].title":  "[\"Deprecated\", \"Fixed\"]",
+		"releases[0].changes[-2:].title": "[\"Deprecated\", \"Fixed\"]",
+		"releases[0].changes[0:1]":       "[{\"title\":\"Added\"}]",
+	} {
+		result, err := apply(query, indexedChangeHeadings())
+		require.NoError(t, err, query)
+		if expected == "" {
+			require.Empty(t, result, query)
+			continue
+		}
+		if expected[0] == '[' || expected[0] == '{' {
+			require.JSONEq(t, expected, result, query)
+			continue
+		}
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func TestChangeQueryByName(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[0].changes[Deprecated].title":          "Deprecated",
+		"releases[0].changes[\"Fixed\"]":                 "{\"title\":\"Fixed\"}",
+		"releases[0].changes[Added].descriptions[]":      "[\"bar\"]",
+		"releases[0].changes[Added]/":                    "{\"title\":\"Added\", \"descriptions\":[\"bar\"]}",
+		"releases[0].changes[Security].title":            "",
+		"releases[].changes[Security].descriptions[]":    "[\"fred\"]",
+		"releases[].changes[Fixed].title":                "[\"Fixed\"]",
+		"releases[1].changes[Security].descriptions[-1]": "fred",
+	} {
+		result, err := apply(query, indexedChangeHeadings())
+		require.NoError(t, err, query)
+		if expected != "" && (expected[0] == '[' || expected[0] == '{') {
+			require.JSONEq(t, expected, result, query)
+			continue
+		}
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func TestChangeQueryByNameNotAChangeKind(t *testing.T) {
+	_, err := apply("releases[0].changes[Bogus].descriptions[]", indexedChangeHeadings())
+	require.EqualError(t, err, "query change \"Bogus\" is not a change kind")
+
+	_, err = apply("releases[0].changes[\"Bogus\"]", indexedChangeHeadings())
+	require.EqualError(t, err, "query change \"Bogus\" is not a change kind")
+}
@@ -17,6 +17,7 @@ func introductionQueryFactory(_ string, _ bool, queryElements []string) (Query,
 func changelogParserConfiguration() parserConfiguration {
 	return parserConfiguration{"introduction", expectedElements{
 		"releases": {false, nil, nil, releaseQueryFactory},
+		"sections": {false, nil, nil, sectionQueryFactory},
 		"title": {true, func(of output.Format, h changelog.Heading) {
 			if h, ok := h.(changelog.Introduction); ok {
 				of.Set(h.DisplayTitle())
@@ -1,8 +1,6 @@
 package query
 
 import (
-	"strings"
-
 	"github.com/denisa/clq/internal/changelog"
 	"github.com/denisa/clq/internal/output"
 )
@@ -12,11 +10,30 @@ type Engine struct {
 	output  output.Format
 	queries []Query
 	current int
+	// buffered is true while the headings are recorded in events, to evaluate the query once the changelog
+	// is complete, when a selection counts from the end of its collection, like `releases[-1]`.
+	buffered bool
+	events   []event
+	size     int
+}
+
+// An event is a heading entered, or exited, by the changelog.
+type event struct {
+	heading changelog.Heading
+	enter   bool
+}
+
+// A collectionKey identifies the collection of a heading: the query accepting it, and its parent,
+// the closest enclosing heading accepted by the previous query.
+type collectionKey struct {
+	parent int
+	query  int
 }
 
 // NewEngine parses the query and constructs a new dedicated query engine.
+// The change kinds tell the names a query can select the changes by.
 // It is not an error for the query to be empty.
-func NewEngine(query string, outputFormat output.Format) (*Engine, error) {
+func NewEngine(query string, changeKind *changelog.ChangeKind, outputFormat output.Format) (*Engine, error) {
 	qe := &Engine{output: outputFormat}
 	if query == "" {
 		return qe, nil
@@ -25,9 +42,15 @@ func NewEngine(query string, outputFormat output.Format) (*Engine, error) {
 	var queryFactory = introductionQueryFactory
 	var selector = ""
 	var isRecursive = false
-	queryElements := strings.Split(query, ".")
+	queryElements, err := splitQuery(query)
+	if err != nil {
+		return nil, err
+	}
 	for i := 0; queryFactory != nil; {
 		if q, parsedElement, err := queryFactory(selector, isRecursive, queryElements[i:]); err == nil {
+			if q, ok := q.(*changeQuery); ok {
+				q.changeKind = changeKind
+			}
 			qe.queries = append(qe.queries, q)
 			if q.isCollection() {
 				outputFormat.SetCollection()
@@ -40,6 +63,11 @@ func NewEngine(query string, outputFormat output.Format) (*Engine, error) {
 			return nil, err
 		}
 	}
+	for _, q := range qe.queries {
+		if c, ok := q.(counter); ok && c.needsSize() {
+			qe.buffered = true
+		}
+	}
 	return qe, nil
 }
 
@@ -47,13 +75,90 @@ func NewEngine(query string, outputFormat output.Format) (*Engine, error) {
 // a Engine with an empty query is a no-op and an be skipped.
 func (qe *Engine) HasQuery() bool { return len(qe.queries) > 0 }
 
-// Result returns the result of the query evaluation.
-func (qe *Engine) Result() string {
-	return qe.output.Result()
+// Result returns the result of the query evaluation, or an error if the query names a heading
+// the changelog does not have.
+func (qe *Engine) Result() (string, error) {
+	if qe.buffered {
+		qe.replay()
+	}
+	for _, q := range qe.queries {
+		if r, ok := q.(resolver); ok {
+			if err := r.unresolved(); err != nil {
+				return "", err
+			}
+		}
+	}
+	return qe.output.Result(), nil
+}
+
+// replay evaluates the query against the recorded headings, now that the size of every collection is known.
+func (qe *Engine) replay() {
+	sizes := qe.sizes()
+	qe.buffered = false
+	for i, e := range qe.events {
+		if e.enter {
+			qe.size = sizes[i]
+			qe.enter(e.heading)
+		} else {
+			qe.exit(e.heading)
+		}
+	}
+	qe.events = nil
+}
+
+// sizes returns, for each recorded heading entered, the number of headings of its collection.
+func (qe *Engine) sizes() []int {
+	keys := make([]collectionKey, len(qe.events))
+	counts := make(map[collectionKey]int)
+	var stack []int
+	for i, e := range qe.events {
+		if !e.enter {
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+		if query := qe.queryOf(e.heading); query > 0 {
+			parent := -1
+			for j := len(stack) - 1; j >= 0; j-- {
+				if qe.queries[query-1].Accept(qe.events[stack[j]].heading) {
+					parent = stack[j]
+					break
+				}
+			}
+			keys[i] = collectionKey{parent, query}
+			counts[keys[i]]++
+		}
+		stack = append(stack, i)
+	}
+
+	sizes := make([]int, len(qe.events))
+	for i, key := range keys {
+		sizes[i] = counts[key]
+	}
+	return sizes
+}
+
+// queryOf returns the index of the last query accepting the heading, -1 if none does.
+func (qe *Engine) queryOf(heading changelog.Heading) int {
+	for i := len(qe.queries) - 1; i >= 0; i-- {
+		if qe.queries[i].Accept(heading) {
+			return i
+		}
+	}
+	return -1
 }
 
 // Enter lets the query engine evaluates the heading upon entering it.
 func (qe *Engine) Enter(heading changelog.Heading) {
+	if qe.buffered {
+		qe.events = append(qe.events, event{heading, true})
+		return
+	}
+	qe.enter(heading)
+}
+
+func (qe *Engine) enter(heading changelog.Heading) {
 	if qe.current == len(qe.queries) {
 		// no queries defined...
 		return
@@ -69,10 +174,19 @@ func (qe *Engine) Enter(heading changelog.Heading) {
 		}
 	}
 
+	if c, ok := qe.queries[qe.current].(counter); ok {
+		c.resize(qe.size)
+	}
 	ok, project := qe.queries[qe.current].Enter(heading)
 	if !ok {
 		return
 	}
+	// the collections of the next queries start anew within this heading.
+	for _, q := range qe.queries[qe.current+1:] {
+		if c, ok := q.(counter); ok {
+			c.reset()
+		}
+	}
 	if project != nil {
 		qe.output.Open(heading)
 		project(qe.output, heading)
@@ -85,6 +199,14 @@ func (qe *Engine) Enter(heading changelog.Heading) {
 
 // Exit lets the query engine evaluates the heading upon leaving it.
 func (qe *Engine) Exit(heading changelog.Heading) {
+	if qe.buffered {
+		qe.events = append(qe.events, event{heading, false})
+		return
+	}
+	qe.exit(heading)
+}
+
+func (qe *Engine) exit(heading changelog.Heading) {
 	if qe.current == len(qe.queries) {
 		// no queries defined...
 		return
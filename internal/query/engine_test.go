@@ -23,7 +23,8 @@ func newQueryEngine(query string, formatName string) (*Engine, error) {
 	if err != nil {
 		return nil, err
 	}
-	qe, err := NewEngine(query, outputFormat)
+	ck, _ := changelog.NewChangeKind("")
+	qe, err := NewEngine(query, ck, outputFormat)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +34,7 @@ func newQueryEngine(query string, formatName string) (*Engine, error) {
 func newHeading(kind changelog.HeadingKind, text string) changelog.Heading {
 	ck, _ := changelog.NewChangeKind("")
 	hf := changelog.NewHeadingFactory(ck)
-	h, err := hf.NewHeading(kind, text)
+	h, err := hf.NewHeading(kind, text, changelog.Position{})
 	if err != nil {
 		panic(err)
 	}
@@ -58,5 +59,5 @@ func apply(query string, headings []changelog.Heading) (string, error) {
 	for i := len(stack) - 1; i >= 0; i-- {
 		qe.Exit(stack[i])
 	}
-	return qe.Result(), nil
+	return qe.Result()
 }
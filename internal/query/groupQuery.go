@@ -0,0 +1,109 @@
+package query
+
+import (
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/output"
+)
+
+func groupQueryFactory(selector string, isRecursive bool, queryElements []string) (Query, parsedElement, error) {
+	selection, err := groupSelection(selector)
+	if err != nil {
+		return nil, parsedElement{}, err
+	}
+
+	queryMe := &groupQuery{selection: selection}
+	queryMe.collection = selectsMany(selector)
+
+	parsedElement := parsedElement{}
+
+	if len(queryElements) == 0 {
+		if isRecursive {
+			queryMe.enter = func(of output.Format, h changelog.Heading) {
+				if h, ok := h.(changelog.Group); ok {
+					of.SetField(jsonNameTitle, h.DisplayTitle())
+					of.Array(jsonNameDescriptions)
+				}
+			}
+			parsedElement.queryFactory = changeItemQueryFactory
+			parsedElement.isRecursive = true
+		} else {
+			queryMe.enter = func(of output.Format, h changelog.Heading) {
+				if h, ok := h.(changelog.Group); ok {
+					of.SetField(jsonNameTitle, h.DisplayTitle())
+				}
+			}
+		}
+		return queryMe, parsedElement, nil
+	}
+
+	parsedElement, projection, err := groupParserConfiguration().parseElement(queryElements)
+	if err != nil {
+		return nil, parsedElement, err
+	}
+
+	return &groupQuery{projection, selection}, parsedElement, nil
+}
+
+// groupSelection returns the selection of the groups of a change: all of them, the one at an index, a slice,
+// the ones matching a predicate, like `title=api`, or the one of a scope, like `api`.
+func groupSelection(selector string) (selection, error) {
+	return parseSelection("group", selector, groupAttributes(), groupNamer)
+}
+
+// groupNamer selects the group of a scope.
+func groupNamer(name string) (filter, error) {
+	return func(h changelog.Heading) bool {
+		group, ok := h.(changelog.Group)
+		return ok && group.Title() == name
+	}, nil
+}
+
+// groupAttributes returns the attributes of a group a predicate can compare.
+func groupAttributes() attributes {
+	return attributes{
+		jsonNameTitle: {textAttribute, func(h changelog.Heading) []string {
+			if h, ok := h.(changelog.Group); ok {
+				return []string{h.Title()}
+			}
+			return nil
+		}},
+	}
+}
+
+func groupParserConfiguration() parserConfiguration {
+	return parserConfiguration{"group", expectedElements{
+		jsonNameDescriptions: {false, nil, nil, changeItemQueryFactory},
+		jsonNameTitle: {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Group); ok {
+				of.Set(h.DisplayTitle())
+			}
+		}, nil, nil}}}
+}
+
+type groupQuery struct {
+	projections
+	selection
+}
+
+func (q *groupQuery) isCollection() bool {
+	return q.collection
+}
+
+func (q *groupQuery) Accept(heading changelog.Heading) bool {
+	_, ok := heading.(changelog.Group)
+	return ok
+}
+
+func (q *groupQuery) Enter(heading changelog.Heading) (bool, project) {
+	if !q.Accept(heading) || !q.selects(heading) {
+		return false, nil
+	}
+	return true, q.enter
+}
+
+func (q *groupQuery) Exit(heading changelog.Heading) (bool, project) {
+	if !q.Accept(heading) {
+		return false, nil
+	}
+	return true, q.exit
+}
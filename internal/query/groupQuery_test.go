@@ -0,0 +1,126 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/stretchr/testify/require"
+)
+
+func groupedHeadings() []changelog.Heading {
+	return []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Fixed"),
+		newHeading(changelog.ChangeDescription, "foo"),
+		newHeading(changelog.GroupHeading, "api"),
+		newHeading(changelog.ChangeDescription, "bar"),
+		newHeading(changelog.ChangeDescription, "baz"),
+		newHeading(changelog.GroupHeading, "cli"),
+		newHeading(changelog.ChangeDescription, "qux"),
+		newHeading(changelog.ChangeHeading, "Security"),
+		newHeading(changelog.ChangeDescription, "waldo"),
+	}
+}
+
+func TestGroupQueryUnsupportedSelector(t *testing.T) {
+	_, err := newQueryEngine("releases[0].changes[].groups[1:b]", "json")
+	require.Error(t, err)
+}
+
+func TestGroupQueryUnsupportedAttribute(t *testing.T) {
+	_, err := newQueryEngine("releases[0].changes[].groups[].fabulator", "json")
+	require.Error(t, err)
+}
+
+func TestGroupQueryAsScalar(t *testing.T) {
+	_, err := newQueryEngine("releases[0].changes[].groups", "json")
+	require.Error(t, err)
+}
+
+func TestGroupQuery(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].changes[].groups[]", groupedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[{\"title\":\"api\"},{\"title\":\"cli\"}]", result)
+}
+
+func TestGroupQueryTitle(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].changes[].groups[].title", groupedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[\"api\",\"cli\"]", result)
+}
+
+func TestGroupQueryRecursive(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].changes[].groups[]/", groupedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[{\"title\":\"api\",\"descriptions\":[\"bar\",\"baz\"]},{\"title\":\"cli\",\"descriptions\":[\"qux\"]}]", result)
+}
+
+func TestGroupQuerySelection(t *testing.T) {
+	testcases := []struct {
+		query    string
+		expected string
+	}{
+		{"releases[0].changes[Fixed].groups[cli]", `{"title":"cli"}`},
+		{`releases[0].changes[Fixed].groups["api"].descriptions[]`, `["bar","baz"]`},
+		{"releases[0].changes[Fixed].groups[1].title", "cli"},
+		{"releases[0].changes[Fixed].groups[-1].title", "cli"},
+		{"releases[0].changes[Fixed].groups[0:1]", `[{"title":"api"}]`},
+		{"releases[0].changes[Fixed].groups[title!=api].title", `["cli"]`},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.query, func(t *testing.T) {
+			result, err := apply(testcase.query, groupedHeadings())
+			require.NoError(t, err)
+			require.Equal(t, testcase.expected, result)
+		})
+	}
+}
+
+func TestGroupQueryDescriptions(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].changes[].groups[].descriptions[]", groupedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[\"bar\",\"baz\",\"qux\"]", result)
+}
+
+func TestChangeQueryDescriptionsIncludeGroups(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].changes[].descriptions[]", groupedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[\"foo\",\"bar\",\"baz\",\"qux\",\"waldo\"]", result)
+}
+
+func TestGroupQueryUnsupportedEnter(t *testing.T) {
+	assertions := require.New(t)
+
+	query := &groupQuery{}
+	assertions.False(query.Enter(newHeading(changelog.ChangeHeading, "Added")))
+}
+
+func TestGroupQueryUnsupportedExit(t *testing.T) {
+	assertions := require.New(t)
+
+	query := &groupQuery{}
+	assertions.False(query.Exit(newHeading(changelog.ChangeHeading, "Added")))
+}
+
+func TestGroupQueryCollection(t *testing.T) {
+	assertions := require.New(t)
+	{
+		query := &groupQuery{}
+		assertions.False(query.isCollection())
+	}
+	{
+		query := &groupQuery{projections: projections{collection: true}}
+		assertions.True(query.isCollection())
+	}
+}
@@ -41,17 +41,59 @@ func (expectedElements parserConfiguration) parseElement(queryElements []string)
 			return parsedElement{}, projections{}, fmt.Errorf("%q is a collection attribute", name)
 		}
 		return parsedElement{selector, isRecursive, expectedElement.queryFactory},
-			projections{expectedElement.enter, expectedElement.exit, !isScalar && selector == ""},
+			projections{expectedElement.enter, expectedElement.exit, !isScalar && selectsMany(selector)},
 			nil
 	}
 
 	return parsedElement{}, projections{}, fmt.Errorf("query attribute not recognized %q for a %q", name, expectedElements.name)
 }
 
+// selectsMany returns true if the selector of a collection selects any number of its elements,
+// all of them, a slice or the ones matching a predicate, rather than a single one.
+func selectsMany(selector string) bool {
+	return selector == "" || isPredicate(selector) || isSlice(selector)
+}
+
+// splitQuery splits a query into its elements, separated by dots outside of the selectors and of their quoted
+// values, so that selectors like `[version>=1.2.0]`, `[v2.3.1]` or `["2.3.1"]` stay whole.
+func splitQuery(query string) ([]string, error) {
+	var elements []string
+	depth, start := 0, 0
+	quoted, escaped := false, false
+	for i, c := range query {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"' && depth > 0:
+			quoted = !quoted
+		case quoted:
+		case c == '[':
+			depth++
+		case c == ']':
+			if depth == 0 {
+				return nil, fmt.Errorf("missing opening bracket in %q", query)
+			}
+			depth--
+		case c == '.' && depth == 0:
+			elements = append(elements, query[start:i])
+			start = i + 1
+		}
+	}
+	switch {
+	case quoted:
+		return nil, fmt.Errorf("missing closing quote in %q", query)
+	case depth > 0:
+		return nil, fmt.Errorf("missing closing bracket in %q", query)
+	}
+	return append(elements, query[start:]), nil
+}
+
 func parseName(element string) (name, selector string, isScalar, isRecursive bool, err error) {
 	isRecursive = strings.HasSuffix(element, "/")
 	openBracketIndex := strings.Index(element, "[")
-	closeBracketIndex := strings.Index(element, "]")
+	closeBracketIndex := strings.LastIndex(element, "]")
 
 	switch {
 	case openBracketIndex != -1:
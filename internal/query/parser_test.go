@@ -157,3 +157,40 @@ func TestParseNameRecursiveListNoSelector(t *testing.T) {
 	assertions.False(isScalar)
 	assertions.True(isRecursive)
 }
+
+func TestSplitQuery(t *testing.T) {
+	for query, expected := range map[string][]string{
+		"releases[version>=1.2.0].changes[].title": {"releases[version>=1.2.0]", "changes[]", "title"},
+		"releases[label=\"a.b\"].version":          {"releases[label=\"a.b\"]", "version"},
+		"releases[v2.3.1].changes[]":               {"releases[v2.3.1]", "changes[]"},
+		"releases[\"2.3.1\"].version":              {"releases[\"2.3.1\"]", "version"},
+		"releases[label=\"a\\\"].b\"].version":     {"releases[label=\"a\\\"].b\"]", "version"},
+		"title":                                    {"title"},
+	} {
+		elements, err := splitQuery(query)
+		require.NoError(t, err, query)
+		require.Equal(t, expected, elements, query)
+	}
+}
+
+func TestSplitQueryErrors(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[0.changes[]":    "missing closing bracket in \"releases[0.changes[]\"",
+		"releases]0[.changes[]":   "missing opening bracket in \"releases]0[.changes[]\"",
+		"releases[\"2.3.1].title": "missing closing quote in \"releases[\\\"2.3.1].title\"",
+	} {
+		_, err := splitQuery(query)
+		require.EqualError(t, err, expected, query)
+	}
+}
+
+func TestParseElementCollectionAttributePredicate(t *testing.T) {
+	parsedElement, projection, errParseElement := parserConfiguration{"succeed", expectedElements{
+		"collection": {false, nil, nil, nil},
+	}}.parseElement([]string{"collection[status=released]"})
+
+	assertions := require.New(t)
+	assertions.NoError(errParseElement)
+	assertions.Equal("status=released", parsedElement.selector)
+	assertions.True(projection.collection)
+}
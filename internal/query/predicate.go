@@ -0,0 +1,133 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/denisa/clq/internal/changelog"
+)
+
+// The kinds of attributes a predicate compares, which tell the operators they support.
+const (
+	textAttribute = iota
+	versionAttribute
+	dateAttribute
+)
+
+// An attribute of a heading a predicate can compare, like the version of a release.
+type attribute struct {
+	kind int
+	// values returns the values of the attribute for the heading; a predicate matches if any value compares.
+	values func(h changelog.Heading) []string
+}
+
+type attributes map[string]attribute
+
+// A filter tells whether a heading of a collection is selected.
+type filter func(h changelog.Heading) bool
+
+var predicateRE = regexp.MustCompile(`^\s*([a-z]+)\s*(=|!=|<=|>=|<|>)\s*(.*?)\s*$`)
+
+// isPredicate returns true if the selector is a predicate, like `status=released` or `version>=1.2.0`.
+func isPredicate(selector string) bool {
+	return predicateRE.MatchString(selector)
+}
+
+// newFilter returns the filter selecting the headings of a collection whose attribute compares with a value,
+// like `status=released`, `version>=1.2.0`, `date>=2024-01-01` or `label="Espelho"`.
+// The value may be quoted. Versions and dates support all the operators, other attributes only = and !=.
+func (a attributes) newFilter(name string, selector string) (filter, error) {
+	matches := predicateRE.FindStringSubmatch(selector)
+	if matches == nil {
+		return nil, fmt.Errorf("query %v selector %q is not a predicate", name, selector)
+	}
+	key, operator, value := matches[1], matches[2], matches[3]
+	attribute, ok := a[key]
+	if !ok {
+		return nil, fmt.Errorf("query %v predicate attribute not recognized %q", name, key)
+	}
+	if len(value) > 1 && value[0] == '"' {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("query %v predicate value %v parsing error: %v", name, value, err)
+		}
+		value = unquoted
+	}
+
+	var compare func(string) (int, bool)
+	switch attribute.kind {
+	case versionAttribute:
+		expected, err := semver.Parse(strings.TrimPrefix(value, "v"))
+		if err != nil {
+			return nil, fmt.Errorf("query %v predicate version %q parsing error: %v", name, value, err)
+		}
+		compare = func(actual string) (int, bool) {
+			version, err := semver.Parse(actual)
+			if err != nil {
+				return 0, false
+			}
+			return version.Compare(expected), true
+		}
+	case dateAttribute:
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, fmt.Errorf("query %v predicate date %q is not a valid date, expected YYYY-MM-DD", name, value)
+		}
+		compare = func(actual string) (int, bool) {
+			if actual == "" {
+				return 0, false
+			}
+			switch {
+			case actual < value:
+				return -1, true
+			case actual > value:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	default:
+		if operator != "=" && operator != "!=" {
+			return nil, fmt.Errorf("query %v predicate attribute %q only supports = and !=", name, key)
+		}
+		compare = func(actual string) (int, bool) {
+			if actual == value {
+				return 0, true
+			}
+			return 1, true
+		}
+	}
+
+	// a heading matches != when it does not match =, even when it has no value, like the version of [Unreleased].
+	negate := operator == "!="
+	if negate {
+		operator = "="
+	}
+	return func(h changelog.Heading) bool {
+		for _, actual := range attribute.values(h) {
+			if c, ok := compare(actual); ok && compares(c, operator) {
+				return !negate
+			}
+		}
+		return negate
+	}, nil
+}
+
+// compares returns true if the result of a comparison satisfies the operator.
+func compares(c int, operator string) bool {
+	switch operator {
+	case "=":
+		return c == 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
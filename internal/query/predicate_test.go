@@ -0,0 +1,44 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPredicate(t *testing.T) {
+	require.True(t, isPredicate("status=released"))
+	require.True(t, isPredicate("version >= 1.2.0"))
+	require.True(t, isPredicate("label=\"Espelho\""))
+	require.False(t, isPredicate(""))
+	require.False(t, isPredicate("0"))
+	require.False(t, isPredicate("three"))
+}
+
+func TestFilterUnreleasedVersion(t *testing.T) {
+	unreleased := newHeading(changelog.ReleaseHeading, "[Unreleased]")
+	for selector, expected := range map[string]bool{
+		"version=1.0.0":     false,
+		"version!=1.0.0":    true,
+		"version<1.0.0":     false,
+		"date>=2020-01-01":  false,
+		"status=unreleased": true,
+	} {
+		filter, err := releaseAttributes().newFilter("release", selector)
+		require.NoError(t, err, selector)
+		require.Equal(t, expected, filter(unreleased), selector)
+	}
+}
+
+func TestFilterNegatesEquality(t *testing.T) {
+	filter, err := changeAttributes().newFilter("change", "title!=Added")
+	require.NoError(t, err)
+	require.True(t, filter(newHeading(changelog.ChangeHeading, "Fixed")))
+	require.False(t, filter(newHeading(changelog.ChangeHeading, "Added")))
+}
+
+func TestFilterNotAPredicate(t *testing.T) {
+	_, err := releaseAttributes().newFilter("release", "0")
+	require.Error(t, err)
+}
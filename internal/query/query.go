@@ -31,3 +31,19 @@ type projections struct {
 	enter, exit project
 	collection  bool
 }
+
+// a counter is a query whose selection counts the headings of its collection.
+type counter interface {
+	// reset restarts the count upon entering a new parent heading.
+	reset()
+	// resize sets the number of headings of the collection.
+	resize(size int)
+	// needsSize returns true if the selection counts from the end of the collection, like `[-1]`.
+	needsSize() bool
+}
+
+// a resolver is a query whose selection names a heading the changelog must have, like `releases[2.3.1]`.
+type resolver interface {
+	// unresolved returns an error if the selection never selected the heading it names.
+	unresolved() error
+}
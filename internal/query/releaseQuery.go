@@ -2,16 +2,22 @@ package query
 
 import (
 	"fmt"
-	"strconv"
+	"strings"
 
 	"github.com/denisa/clq/internal/changelog"
 	"github.com/denisa/clq/internal/output"
 )
 
+const (
+	jsonNameYanked      string = "yanked"
+	jsonNameReason      string = "reason"
+	jsonNameReplacement string = "replacement"
+)
+
 func releaseQueryFactory(selector string, _ bool, queryElements []string) (Query, parsedElement, error) {
-	i, err := strconv.Atoi(selector)
+	selection, err := releaseSelection(selector)
 	if err != nil {
-		return nil, parsedElement{}, fmt.Errorf("query release selector %q parsing error: %v", selector, err)
+		return nil, parsedElement{}, err
 	}
 
 	if len(queryElements) == 0 {
@@ -22,16 +28,82 @@ func releaseQueryFactory(selector string, _ bool, queryElements []string) (Query
 					of.SetField("date", h.Date())
 				}
 			}, nil, false,
-			}, 0, i,
+			}, selection,
 		}, parsedElement{}, nil
 	}
 
+	if queryElements[0] == jsonNameYanked && len(queryElements) > 1 {
+		pe, projection, err := yankedParserConfiguration().parseElement(queryElements[1:])
+		if err != nil {
+			return nil, parsedElement{}, err
+		}
+		return &releaseQuery{projection, selection}, pe, nil
+	}
+
 	pe, projection, err := releaseParserConfiguration().parseElement(queryElements)
 	if err != nil {
 		return nil, parsedElement{}, err
 	}
 
-	return &releaseQuery{projection, 0, i}, pe, nil
+	return &releaseQuery{projection, selection}, pe, nil
+}
+
+// releaseSelection returns the selection of the releases: all of them, the one at an index, a slice,
+// the ones matching a predicate, like `status=released` or `version>=1.2.0`, or the one of a version,
+// like `2.3.1`, `v2.3.1`, `"2.3.1"` or `Unreleased`.
+func releaseSelection(selector string) (selection, error) {
+	return parseSelection("release", selector, releaseAttributes(), releaseNamer)
+}
+
+// releaseNamer selects the release of a version, ignoring any `v` prefix, or the unreleased one.
+func releaseNamer(name string) (filter, error) {
+	if strings.EqualFold(name, "unreleased") {
+		return func(h changelog.Heading) bool {
+			release, ok := h.(changelog.Release)
+			return ok && !release.HasBeenReleased()
+		}, nil
+	}
+	version, err := changelog.NormalizeVersion(name)
+	if err != nil {
+		return nil, fmt.Errorf("query release selector %q parsing error: %v", name, err)
+	}
+	return func(h changelog.Heading) bool {
+		release, ok := h.(changelog.Release)
+		return ok && release.HasBeenReleased() && release.Version() == version
+	}, nil
+}
+
+// releaseAttributes returns the attributes of a release a predicate can compare.
+func releaseAttributes() attributes {
+	release := func(value func(h changelog.Release) string) func(h changelog.Heading) []string {
+		return func(h changelog.Heading) []string {
+			if h, ok := h.(changelog.Release); ok {
+				return []string{value(h)}
+			}
+			return nil
+		}
+	}
+	return attributes{
+		"date":    {dateAttribute, release(changelog.Release.Date)},
+		"label":   {textAttribute, release(changelog.Release.Label)},
+		"status":  {textAttribute, release(releaseStatus)},
+		"title":   {textAttribute, release(changelog.Release.DisplayTitle)},
+		"version": {versionAttribute, release(changelog.Release.Version)},
+	}
+}
+
+// releaseStatus returns the status of a release, one of prereleased, released, unreleased and yanked.
+func releaseStatus(h changelog.Release) string {
+	switch {
+	case !h.HasBeenReleased():
+		return "unreleased"
+	case h.HasBeenYanked():
+		return "yanked"
+	case h.IsPrerelease():
+		return "prereleased"
+	default:
+		return "released"
+	}
 }
 
 func releaseParserConfiguration() parserConfiguration {
@@ -49,16 +121,7 @@ func releaseParserConfiguration() parserConfiguration {
 		}, nil, nil},
 		"status": {true, func(of output.Format, h changelog.Heading) {
 			if h, ok := h.(changelog.Release); ok {
-				switch {
-				case !h.HasBeenReleased():
-					of.Set("unreleased")
-				case h.HasBeenYanked():
-					of.Set("yanked")
-				case h.IsPrerelease():
-					of.Set("prereleased")
-				default:
-					of.Set("released")
-				}
+				of.Set(releaseStatus(h))
 			}
 		}, nil, nil},
 		"title": {true, func(of output.Format, h changelog.Heading) {
@@ -66,18 +129,44 @@ func releaseParserConfiguration() parserConfiguration {
 				of.Set(h.DisplayTitle())
 			}
 		}, nil, nil},
+		"url": {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Release); ok {
+				of.Set(h.URL())
+			}
+		}, nil, nil},
 		"version": {true, func(of output.Format, h changelog.Heading) {
 			if h, ok := h.(changelog.Release); ok {
 				of.Set(h.Version())
 			}
 		}, nil, nil},
+		jsonNameYanked: {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Release); ok && h.HasBeenYanked() {
+				of.SetField(jsonNameReason, h.YankedReason())
+				of.SetField(jsonNameReplacement, h.Replacement())
+			}
+		}, nil, nil},
+	}}
+}
+
+// yankedParserConfiguration parses the attributes of the yanked object of a release, like `yanked.reason`.
+func yankedParserConfiguration() parserConfiguration {
+	return parserConfiguration{"yanked", expectedElements{
+		jsonNameReason: {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Release); ok {
+				of.Set(h.YankedReason())
+			}
+		}, nil, nil},
+		jsonNameReplacement: {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Release); ok {
+				of.Set(h.Replacement())
+			}
+		}, nil, nil},
 	}}
 }
 
 type releaseQuery struct {
 	projections
-	cursor int
-	index  int
+	selection
 }
 
 func (q *releaseQuery) isCollection() bool {
@@ -93,10 +182,7 @@ func (q *releaseQuery) Enter(heading changelog.Heading) (bool, project) {
 	if !q.Accept(heading) {
 		return false, nil
 	}
-	selected := q.cursor == q.index
-	q.cursor++
-
-	if !selected {
+	if !q.selects(heading) {
 		return false, nil
 	}
 	return true, q.enter
@@ -108,3 +194,11 @@ func (q *releaseQuery) Exit(heading changelog.Heading) (bool, project) {
 	}
 	return true, q.exit
 }
+
+// unresolved returns an error if the query names a release the changelog does not have, like `releases[9.9.9]`.
+func (q *releaseQuery) unresolved() error {
+	if q.named == "" || q.resolved {
+		return nil
+	}
+	return fmt.Errorf("query release %q not found", q.named)
+}
@@ -164,6 +164,68 @@ func TestReleaseQuerySecondReleaseStatusYanked(t *testing.T) {
 	assertions.Equal("yanked", result)
 }
 
+func yankedHeadings() []changelog.Heading {
+	return []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ReleaseHeading, "[1.2.2] - 2020-05-15 [YANKED] reason: data loss, use 1.2.3"),
+	}
+}
+
+func TestReleaseQueryYanked(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[1].yanked", yankedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("{\"reason\":\"data loss\", \"replacement\":\"1.2.3\"}", result)
+}
+
+func TestReleaseQueryNotYanked(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].yanked", yankedHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("{}", result)
+}
+
+func TestReleaseQueryYankedReason(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[1].yanked.reason", yankedHeadings())
+	assertions.NoError(err)
+	assertions.Equal("data loss", result)
+}
+
+func TestReleaseQueryYankedReplacement(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[1].yanked.replacement", yankedHeadings())
+	assertions.NoError(err)
+	assertions.Equal("1.2.3", result)
+}
+
+func TestReleaseQueryYankedUnsupportedAttribute(t *testing.T) {
+	_, err := newQueryEngine("releases[1].yanked.date", "json")
+	require.Error(t, err)
+}
+
+func TestReleaseQueryYankedUnsupportedFurtherElement(t *testing.T) {
+	_, err := newQueryEngine("releases[1].yanked.reason.size", "json")
+	require.Error(t, err)
+}
+
+func TestReleaseQueryURL(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[1].url", []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[Unreleased](https://host/compare/v1.2.2...HEAD)"),
+		newHeading(changelog.ReleaseHeading, "[1.2.2](https://host/compare/v1.2.1...v1.2.2) - 2020-05-15"),
+	})
+	assertions.NoError(err)
+	assertions.Equal("https://host/compare/v1.2.1...v1.2.2", result)
+}
+
 func TestReleaseQuerySecondReleaseTitle(t *testing.T) {
 	assertions := require.New(t)
 
@@ -214,3 +276,124 @@ func TestReleaseQueryCollection(t *testing.T) {
 		assertions.True(query.isCollection())
 	}
 }
+
+func filteredHeadings() []changelog.Heading {
+	return []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newHeading(changelog.ReleaseHeading, "[Unreleased]"),
+		newHeading(changelog.ReleaseHeading, "[1.3.0] - 2024-02-01 Espelho"),
+		newHeading(changelog.ReleaseHeading, "[1.2.1] - 2024-01-10 [YANKED]"),
+		newHeading(changelog.ReleaseHeading, "[1.2.0] - 2023-12-24"),
+		newHeading(changelog.ReleaseHeading, "[1.1.0] - 2023-06-01"),
+	}
+}
+
+func TestReleaseQueryAllVersions(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[].version", filteredHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[\"\", \"1.3.0\", \"1.2.1\", \"1.2.0\", \"1.1.0\"]", result)
+}
+
+func TestReleaseQueryPredicates(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[status=released].version":      "[\"1.3.0\", \"1.2.0\", \"1.1.0\"]",
+		"releases[status!=released].version":     "[\"\", \"1.2.1\"]",
+		"releases[version>=1.2.0].version":       "[\"1.3.0\", \"1.2.1\", \"1.2.0\"]",
+		"releases[version<1.2.1].version":        "[\"1.2.0\", \"1.1.0\"]",
+		"releases[version=v1.2.1].version":       "[\"1.2.1\"]",
+		"releases[label=\"Espelho\"].version":    "[\"1.3.0\"]",
+		"releases[label=Espelho].version":        "[\"1.3.0\"]",
+		"releases[date>=2024-01-01].version":     "[\"1.3.0\", \"1.2.1\"]",
+		"releases[date<2024-01-01].version":      "[\"1.2.0\", \"1.1.0\"]",
+		"releases[title=\"[Unreleased]\"].title": "[\"[Unreleased]\"]",
+		"releases[version>2.0.0].version":        "[]",
+	} {
+		result, err := apply(query, filteredHeadings())
+		require.NoError(t, err, query)
+		require.JSONEq(t, expected, result, query)
+	}
+}
+
+func TestReleaseQueryPredicateObjects(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[version>=1.3.0]", filteredHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[{\"version\":\"1.3.0\", \"date\":\"2024-02-01\"}]", result)
+}
+
+func TestReleaseQueryPredicateErrors(t *testing.T) {
+	for _, query := range []string{
+		"releases[publication>=2024-01-01]",
+		"releases[version>=next]",
+		"releases[date>=01/01/2024]",
+		"releases[label>Espelho]",
+		"releases[label=\"Espelho]",
+	} {
+		_, err := newQueryEngine(query, "json")
+		require.Error(t, err, query)
+	}
+}
+
+func TestReleaseQueryNegativeIndex(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[-1].version", filteredHeadings())
+	assertions.NoError(err)
+	assertions.Equal("1.1.0", result)
+
+	result, err = apply("releases[-2]", filteredHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("{\"version\":\"1.2.0\", \"date\":\"2023-12-24\"}", result)
+}
+
+func TestReleaseQuerySlices(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[0:2].version":  "[\"\", \"1.3.0\"]",
+		"releases[3:].version":   "[\"1.2.0\", \"1.1.0\"]",
+		"releases[-2:].version":  "[\"1.2.0\", \"1.1.0\"]",
+		"releases[1:-3].version": "[\"1.3.0\"]",
+		"releases[0:1].version":  "[\"\"]",
+		"releases[9:].version":   "[]",
+	} {
+		result, err := apply(query, filteredHeadings())
+		require.NoError(t, err, query)
+		require.JSONEq(t, expected, result, query)
+	}
+}
+
+func TestReleaseQueryByVersion(t *testing.T) {
+	for query, expected := range map[string]string{
+		"releases[1.2.0].date":       "2023-12-24",
+		"releases[v1.3.0].label":     "Espelho",
+		"releases[\"1.2.1\"].status": "yanked",
+		"releases[\"v1.1.0\"]":       "{\"version\":\"1.1.0\", \"date\":\"2023-06-01\"}",
+		"releases[Unreleased].title": "[Unreleased]",
+		"releases[unreleased]":       "{\"version\":\"\", \"date\":\"\"}",
+	} {
+		result, err := apply(query, filteredHeadings())
+		require.NoError(t, err, query)
+		if expected[0] == '{' {
+			require.JSONEq(t, expected, result, query)
+			continue
+		}
+		require.Equal(t, expected, result, query)
+	}
+}
+
+func TestReleaseQueryByVersionNotFound(t *testing.T) {
+	_, err := apply("releases[2.3.1].version", filteredHeadings())
+	require.EqualError(t, err, "query release \"2.3.1\" not found")
+
+	_, err = apply("releases[Unreleased].version", filteredHeadings()[2:])
+	require.EqualError(t, err, "query release \"Unreleased\" not found")
+}
+
+func TestReleaseQueryByVersionParsingError(t *testing.T) {
+	for _, query := range []string{"releases[next]", "releases[\"1.2\"]", "releases[\"1.2.0].version"} {
+		_, err := newQueryEngine(query, "json")
+		require.Error(t, err, query)
+	}
+}
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/output"
+)
+
+const jsonNameContent string = "content"
+
+func sectionQueryFactory(selector string, _ bool, queryElements []string) (Query, parsedElement, error) {
+	selection, err := sectionSelection(selector)
+	if err != nil {
+		return nil, parsedElement{}, err
+	}
+
+	if len(queryElements) == 0 {
+		queryMe := &sectionQuery{selection: selection}
+		queryMe.collection = selectsMany(selector)
+		queryMe.enter = func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Section); ok {
+				of.SetField(jsonNameTitle, h.DisplayTitle())
+				of.SetField(jsonNameContent, h.Content())
+			}
+		}
+		return queryMe, parsedElement{}, nil
+	}
+
+	pe, projection, err := sectionParserConfiguration().parseElement(queryElements)
+	if err != nil {
+		return nil, parsedElement{}, err
+	}
+
+	return &sectionQuery{projection, selection}, pe, nil
+}
+
+// sectionSelection returns the selection of the sections: all of them, the one at an index, a slice,
+// the ones matching a predicate, like `title=Legend`, or the one with a title, like `Legend`.
+func sectionSelection(selector string) (selection, error) {
+	return parseSelection("section", selector, sectionAttributes(), sectionNamer)
+}
+
+// sectionNamer selects the section with a title.
+func sectionNamer(name string) (filter, error) {
+	return func(h changelog.Heading) bool {
+		section, ok := h.(changelog.Section)
+		return ok && section.Title() == name
+	}, nil
+}
+
+// sectionAttributes returns the attributes of a section a predicate can compare.
+func sectionAttributes() attributes {
+	return attributes{
+		jsonNameTitle: {textAttribute, func(h changelog.Heading) []string {
+			if h, ok := h.(changelog.Section); ok {
+				return []string{h.Title()}
+			}
+			return nil
+		}},
+	}
+}
+
+func sectionParserConfiguration() parserConfiguration {
+	return parserConfiguration{"section", expectedElements{
+		jsonNameContent: {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Section); ok {
+				of.Set(h.Content())
+			}
+		}, nil, nil},
+		jsonNameTitle: {true, func(of output.Format, h changelog.Heading) {
+			if h, ok := h.(changelog.Section); ok {
+				of.Set(h.DisplayTitle())
+			}
+		}, nil, nil},
+	}}
+}
+
+type sectionQuery struct {
+	projections
+	selection
+}
+
+func (q *sectionQuery) isCollection() bool {
+	return q.collection
+}
+
+func (q *sectionQuery) Accept(heading changelog.Heading) bool {
+	_, ok := heading.(changelog.Section)
+	return ok
+}
+
+func (q *sectionQuery) Enter(heading changelog.Heading) (bool, project) {
+	if !q.Accept(heading) || !q.selects(heading) {
+		return false, nil
+	}
+	return true, q.enter
+}
+
+func (q *sectionQuery) Exit(heading changelog.Heading) (bool, project) {
+	if !q.Accept(heading) {
+		return false, nil
+	}
+	return true, q.exit
+}
@@ -0,0 +1,107 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/stretchr/testify/require"
+)
+
+func newSection(title string, content string) changelog.Heading {
+	ck, _ := changelog.NewChangeKind("")
+	hf := changelog.NewHeadingFactory(ck)
+	h, err := hf.NewSection(title, changelog.Position{}, content)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+func sectionHeadings() []changelog.Heading {
+	return []changelog.Heading{
+		newHeading(changelog.IntroductionHeading, "changelog"),
+		newSection("Legend", "- *Added* for new features."),
+		newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16"),
+		newHeading(changelog.ChangeHeading, "Added"),
+		newHeading(changelog.ChangeDescription, "foo"),
+		newSection("Older releases", "See the archive."),
+	}
+}
+
+func TestSectionQueryUnsupportedSelector(t *testing.T) {
+	_, err := newQueryEngine("sections[1:b]", "json")
+	require.Error(t, err)
+}
+
+func TestSectionQueryUnsupportedAttribute(t *testing.T) {
+	_, err := newQueryEngine("sections[].fabulator", "json")
+	require.Error(t, err)
+}
+
+func TestSectionQuery(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("sections[]", sectionHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[{\"title\":\"Legend\",\"content\":\"- *Added* for new features.\"},{\"title\":\"Older releases\",\"content\":\"See the archive.\"}]", result)
+}
+
+func TestSectionQueryTitle(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("sections[].title", sectionHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[\"Legend\",\"Older releases\"]", result)
+}
+
+func TestSectionQueryContent(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("sections[].content", sectionHeadings())
+	assertions.NoError(err)
+	assertions.JSONEq("[\"- *Added* for new features.\",\"See the archive.\"]", result)
+}
+
+func TestSectionQuerySelection(t *testing.T) {
+	for _, tc := range []struct {
+		selector string
+		expected string
+	}{
+		{"0", "Legend"},
+		{"-1", "Older releases"},
+		{"1:", `["Older releases"]`},
+		{"Legend", "Legend"},
+		{`"Older releases"`, "Older releases"},
+		{"title!=Legend", `["Older releases"]`},
+	} {
+		t.Run(tc.selector, func(t *testing.T) {
+			assertions := require.New(t)
+
+			result, err := apply("sections["+tc.selector+"].title", sectionHeadings())
+			assertions.NoError(err)
+			assertions.Equal(tc.expected, result)
+		})
+	}
+}
+
+func TestSectionQueryIgnoredByReleases(t *testing.T) {
+	assertions := require.New(t)
+
+	result, err := apply("releases[0].version", sectionHeadings())
+	assertions.NoError(err)
+	assertions.Equal("1.2.3", result)
+}
+
+func TestSectionQueryUnsupportedEnter(t *testing.T) {
+	assertions := require.New(t)
+
+	query := &sectionQuery{}
+	assertions.False(query.Enter(newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16")))
+}
+
+func TestSectionQueryUnsupportedExit(t *testing.T) {
+	assertions := require.New(t)
+
+	query := &sectionQuery{}
+	assertions.False(query.Exit(newHeading(changelog.ReleaseHeading, "[1.2.3] - 2020-05-16")))
+}
@@ -0,0 +1,141 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/denisa/clq/internal/changelog"
+)
+
+// A selection selects the headings of a collection: all of them, the one at an index, the ones of a slice,
+// the ones matching a predicate, or the one with a name. Negative indices count from the end of the collection.
+type selection struct {
+	filter     filter
+	index      int
+	indexed    bool
+	start, end int
+	bounded    bool
+	size       int
+	cursor     int
+	// selected is true if the last heading of the collection is selected.
+	selected bool
+	// named is the name of the heading selected by its name, if any.
+	named string
+	// resolved is true if any heading of any collection has been selected.
+	resolved bool
+}
+
+// A namer returns the filter selecting the heading with a name, like the change kind `Security`.
+type namer func(name string) (filter, error)
+
+// parseSelection returns the selection of a selector: empty for all the headings, an index like `0` or `-1`,
+// a slice like `0:3`, `2:` or `-3:`, a predicate on the attributes, if any, like `status=released`,
+// or a name, if the namer is set, like `Security` or `"Security"`.
+func parseSelection(name string, selector string, attributes attributes, namer namer) (selection, error) {
+	switch {
+	case selector == "":
+		return selection{}, nil
+	case isQuoted(selector):
+		if namer == nil {
+			return selection{}, fmt.Errorf("query %v selector %q not supported", name, selector)
+		}
+		unquoted, err := strconv.Unquote(strings.TrimSpace(selector))
+		if err != nil {
+			return selection{}, fmt.Errorf("query %v selector %q parsing error: %v", name, selector, err)
+		}
+		return newNamedSelection(namer, unquoted)
+	case isPredicate(selector):
+		if attributes == nil {
+			return selection{}, fmt.Errorf("query %v selector %q not supported", name, selector)
+		}
+		filter, err := attributes.newFilter(name, selector)
+		return selection{filter: filter}, err
+	case isSlice(selector):
+		from, to, _ := strings.Cut(selector, ":")
+		start, err := parseBound(name, selector, from)
+		if err != nil {
+			return selection{}, err
+		}
+		end, err := parseBound(name, selector, to)
+		if err != nil {
+			return selection{}, err
+		}
+		return selection{start: start, end: end, bounded: strings.TrimSpace(to) != ""}, nil
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(selector))
+	if err != nil {
+		if namer != nil {
+			return newNamedSelection(namer, strings.TrimSpace(selector))
+		}
+		return selection{}, fmt.Errorf("query %v selector %q parsing error: %v", name, selector, err)
+	}
+	return selection{index: i, indexed: true}, nil
+}
+
+// newNamedSelection returns the selection of the heading with a name.
+func newNamedSelection(namer namer, name string) (selection, error) {
+	filter, err := namer(name)
+	if err != nil {
+		return selection{}, err
+	}
+	return selection{filter: filter, named: name}, nil
+}
+
+// isQuoted returns true if the selector is a quoted name, like `"Security"`.
+func isQuoted(selector string) bool {
+	return strings.HasPrefix(strings.TrimSpace(selector), `"`)
+}
+
+// isSlice returns true if the selector is a slice, like `0:3`.
+func isSlice(selector string) bool {
+	return !isQuoted(selector) && strings.Contains(selector, ":")
+}
+
+// parseBound returns a bound of a slice, 0 if it is omitted.
+func parseBound(name string, selector string, bound string) (int, error) {
+	if strings.TrimSpace(bound) == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(bound))
+	if err != nil {
+		return 0, fmt.Errorf("query %v selector %q parsing error: %v", name, selector, err)
+	}
+	return i, nil
+}
+
+// selects returns true if the heading, the next one of the collection, is selected.
+func (s *selection) selects(h changelog.Heading) bool {
+	position := s.cursor
+	s.cursor++
+	switch {
+	case s.filter != nil:
+		s.selected = s.filter(h)
+	case s.indexed:
+		s.selected = position == s.resolve(s.index)
+	default:
+		s.selected = position >= s.resolve(s.start) && (!s.bounded || position < s.resolve(s.end))
+	}
+	s.resolved = s.resolved || s.selected
+	return s.selected
+}
+
+// resolve returns the position of an index, counting negative indices from the end of the collection.
+func (s *selection) resolve(index int) int {
+	if index < 0 {
+		return index + s.size
+	}
+	return index
+}
+
+func (s *selection) reset() {
+	s.cursor = 0
+}
+
+func (s *selection) resize(size int) {
+	s.size = size
+}
+
+func (s *selection) needsSize() bool {
+	return (s.indexed && s.index < 0) || s.start < 0 || (s.bounded && s.end < 0)
+}
@@ -0,0 +1,85 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/stretchr/testify/require"
+)
+
+// selected returns the positions the selection selects in a collection of the given size.
+func selected(s selection, size int) []int {
+	h := newHeading(changelog.ChangeHeading, "Added")
+	s.resize(size)
+	s.reset()
+	result := []int{}
+	for i := 0; i < size; i++ {
+		if s.selects(h) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func TestParseSelection(t *testing.T) {
+	for selector, expected := range map[string][]int{
+		"":     {0, 1, 2, 3, 4},
+		"0":    {0},
+		"3":    {3},
+		"7":    {},
+		"-1":   {4},
+		"-5":   {0},
+		"-6":   {},
+		"0:3":  {0, 1, 2},
+		"2:":   {2, 3, 4},
+		":2":   {0, 1},
+		"-3:":  {2, 3, 4},
+		"1:-1": {1, 2, 3},
+		"3:1":  {},
+		"0:9":  {0, 1, 2, 3, 4},
+	} {
+		s, err := parseSelection("change", selector, nil, nil)
+		require.NoError(t, err, selector)
+		require.Equal(t, expected, selected(s, 5), selector)
+	}
+}
+
+func TestParseSelectionErrors(t *testing.T) {
+	for _, selector := range []string{"three", "a:2", "1:b", "1:2:3", "title=Added", `"Added"`} {
+		_, err := parseSelection("change", selector, nil, nil)
+		require.Error(t, err, selector)
+	}
+}
+
+func TestSelectionNeedsSize(t *testing.T) {
+	for selector, expected := range map[string]bool{
+		"":    false,
+		"0":   false,
+		"-1":  true,
+		"0:3": false,
+		"2:":  false,
+		"-3:": true,
+		":-1": true,
+	} {
+		s, err := parseSelection("change", selector, nil, nil)
+		require.NoError(t, err, selector)
+		require.Equal(t, expected, s.needsSize(), selector)
+	}
+}
+
+func TestParseSelectionByName(t *testing.T) {
+	namer := func(name string) (filter, error) {
+		return func(h changelog.Heading) bool { return h.Title() == name }, nil
+	}
+	for _, selector := range []string{"Added", " Added ", `"Added"`} {
+		s, err := parseSelection("change", selector, nil, namer)
+		require.NoError(t, err, selector)
+		require.Equal(t, []int{0, 1}, selected(s, 2), selector)
+		require.False(t, selectsMany(selector), selector)
+	}
+	s, err := parseSelection("change", "Fixed", nil, namer)
+	require.NoError(t, err)
+	require.Equal(t, []int{}, selected(s, 2))
+	_, err = parseSelection("change", `"Added`, nil, namer)
+	require.Error(t, err)
+}
@@ -0,0 +1,133 @@
+// Package rule identifies the validation rules applied to a changelog.
+package rule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Rule is a validation rule, with a stable identifier and a descriptive name.
+// The violation of a structural rule leaves the changelog undecidable, such a rule
+// cannot be turned off or downgraded.
+type Rule struct {
+	ID              string
+	Name            string
+	structural      bool
+	defaultSeverity Severity
+}
+
+var (
+	HeadingLevel              = Rule{"CLQ001", "heading-level", true, Error}
+	IntroductionTitle         = Rule{"CLQ002", "introduction-title", true, Error}
+	ReleaseHeading            = Rule{"CLQ003", "release-heading", true, Error}
+	ChangeHeading             = Rule{"CLQ004", "change-heading", true, Error}
+	ChangeDescription         = Rule{"CLQ005", "change-description", true, Error}
+	ChangeOutsideRelease      = Rule{"CLQ006", "change-outside-release", true, Error}
+	ReleaseMissing            = Rule{"CLQ007", "release-missing", false, Error}
+	UnreleasedMultiple        = Rule{"CLQ008", "unreleased-multiple", false, Error}
+	UnreleasedFirst           = Rule{"CLQ009", "unreleased-first", false, Error}
+	VersionIncrement          = Rule{"CLQ010", "version-increment", false, Error}
+	VersionOrder              = Rule{"CLQ011", "version-order", false, Error}
+	DateOrder                 = Rule{"CLQ012", "date-order", false, Error}
+	YankedFirst               = Rule{"CLQ013", "yanked-first", false, Error}
+	BuildOnly                 = Rule{"CLQ014", "build-only", false, Error}
+	ChangeHeadingDuplicate    = Rule{"CLQ015", "change-heading-duplicate", false, Error}
+	ChangeDescriptionsMissing = Rule{"CLQ016", "change-descriptions-missing", false, Error}
+	ReleaseModeUnreleased     = Rule{"CLQ017", "release-mode-unreleased", false, Error}
+	UnreleasedEmpty           = Rule{"CLQ018", "unreleased-empty", false, Warning}
+	ReleaseDateFuture         = Rule{"CLQ019", "release-date-future", false, Warning}
+	DescriptionLength         = Rule{"CLQ020", "description-length", false, Warning}
+	LinkMissing               = Rule{"CLQ021", "link-missing", false, Error}
+	LinkOrphan                = Rule{"CLQ022", "link-orphan", false, Error}
+	LinkOrder                 = Rule{"CLQ023", "link-order", false, Error}
+	LinkCompare               = Rule{"CLQ024", "link-compare", false, Error}
+	ReleaseAge                = Rule{"CLQ025", "release-age", false, Error}
+	BuildIncrement            = Rule{"CLQ026", "build-increment", false, Error}
+	ExpectedRelease           = Rule{"CLQ027", "expected-release", false, Error}
+	DescriptionDuplicate      = Rule{"CLQ028", "description-duplicate", false, Error}
+	StrictContent             = Rule{"CLQ029", "strict-content", false, Error}
+	DescriptionFormat         = Rule{"CLQ030", "description-format", false, Error}
+	ChangeOrder               = Rule{"CLQ031", "change-order", false, Warning}
+	GroupOutsideChange        = Rule{"CLQ032", "group-outside-change", true, Error}
+	GroupHeading              = Rule{"CLQ033", "group-heading", true, Error}
+	GroupHeadingDuplicate     = Rule{"CLQ034", "group-heading-duplicate", false, Error}
+	GroupScope                = Rule{"CLQ035", "group-scope", false, Error}
+	SectionOrder              = Rule{"CLQ036", "section-order", false, Error}
+	YankedReplacement         = Rule{"CLQ037", "yanked-replacement", false, Error}
+	ChangeEmoji               = Rule{"CLQ038", "change-emoji", false, Error}
+	MaxIncrement              = Rule{"CLQ039", "max-increment", false, Error}
+	ChangeForbidden           = Rule{"CLQ040", "change-forbidden", false, Error}
+)
+
+// registry lists all the rules, ordered by identifier.
+var registry = []Rule{
+	HeadingLevel,
+	IntroductionTitle,
+	ReleaseHeading,
+	ChangeHeading,
+	ChangeDescription,
+	ChangeOutsideRelease,
+	ReleaseMissing,
+	UnreleasedMultiple,
+	UnreleasedFirst,
+	VersionIncrement,
+	VersionOrder,
+	DateOrder,
+	YankedFirst,
+	BuildOnly,
+	ChangeHeadingDuplicate,
+	ChangeDescriptionsMissing,
+	ReleaseModeUnreleased,
+	UnreleasedEmpty,
+	ReleaseDateFuture,
+	DescriptionLength,
+	LinkMissing,
+	LinkOrphan,
+	LinkOrder,
+	LinkCompare,
+	ReleaseAge,
+	BuildIncrement,
+	ExpectedRelease,
+	DescriptionDuplicate,
+	StrictContent,
+	DescriptionFormat,
+	ChangeOrder,
+	GroupOutsideChange,
+	GroupHeading,
+	GroupHeadingDuplicate,
+	GroupScope,
+	SectionOrder,
+	YankedReplacement,
+	ChangeEmoji,
+	MaxIncrement,
+	ChangeForbidden,
+}
+
+// Rules returns all the rules, ordered by identifier.
+func Rules() []Rule {
+	return append([]Rule(nil), registry...)
+}
+
+// Lookup returns the rule with the given identifier or name.
+func Lookup(key string) (Rule, error) {
+	for _, r := range registry {
+		if strings.EqualFold(r.ID, key) || r.Name == key {
+			return r, nil
+		}
+	}
+	return Rule{}, fmt.Errorf("unknown rule %q", key)
+}
+
+// IsStructural returns true if the violation of the rule always stops the validation.
+func (r Rule) IsStructural() bool {
+	return r.structural
+}
+
+// DefaultSeverity returns the severity of the rule when it is not configured.
+func (r Rule) DefaultSeverity() Severity {
+	return r.defaultSeverity
+}
+
+func (r Rule) String() string {
+	return r.ID + " " + r.Name
+}
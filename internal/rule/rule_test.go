@@ -0,0 +1,60 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleString(t *testing.T) {
+	require.Equal(t, "CLQ010 version-increment", VersionIncrement.String())
+}
+
+func TestRulesAreUnique(t *testing.T) {
+	ids := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, r := range Rules() {
+		require.Falsef(t, ids[r.ID], "duplicate identifier %v", r.ID)
+		require.Falsef(t, names[r.Name], "duplicate name %v", r.Name)
+		ids[r.ID] = true
+		names[r.Name] = true
+	}
+}
+
+func TestRulesAreSorted(t *testing.T) {
+	rules := Rules()
+	for i := 1; i < len(rules); i++ {
+		require.Less(t, rules[i-1].ID, rules[i].ID)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, key := range []string{"CLQ010", "clq010", "version-increment"} {
+		r, err := Lookup(key)
+		require.NoError(t, err)
+		require.Equal(t, VersionIncrement, r)
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	_, err := Lookup("CLQ999")
+	require.EqualError(t, err, "unknown rule \"CLQ999\"")
+}
+
+func TestIsStructural(t *testing.T) {
+	require.True(t, HeadingLevel.IsStructural())
+	require.False(t, VersionIncrement.IsStructural())
+}
+
+func TestDefaultSeverity(t *testing.T) {
+	require.Equal(t, Error, VersionIncrement.DefaultSeverity())
+	require.Equal(t, Warning, UnreleasedEmpty.DefaultSeverity())
+}
+
+func TestStructuralRulesAreErrors(t *testing.T) {
+	for _, r := range Rules() {
+		if r.IsStructural() {
+			require.Equal(t, Error, r.DefaultSeverity(), r.String())
+		}
+	}
+}
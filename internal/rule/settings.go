@@ -0,0 +1,51 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Settings overrides the default severity of rules.
+type Settings map[Rule]Severity
+
+// Set sets the severity, by name, of the rule with the given identifier or name.
+func (s Settings) Set(key string, severityName string) error {
+	r, err := Lookup(strings.TrimSpace(key))
+	if err != nil {
+		return err
+	}
+	severity, err := NewSeverity(strings.TrimSpace(severityName))
+	if err != nil {
+		return fmt.Errorf("rule %v: %w", r, err)
+	}
+	if r.IsStructural() && severity != Error {
+		return fmt.Errorf("rule %v cannot be configured", r)
+	}
+	s[r] = severity
+	return nil
+}
+
+// Parse sets the severities listed in a comma-separated list of rule=severity settings.
+func (s Settings) Parse(list string) error {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	for _, setting := range strings.Split(list, ",") {
+		key, severityName, ok := strings.Cut(setting, "=")
+		if !ok {
+			return fmt.Errorf("%q is not a rule=severity setting", setting)
+		}
+		if err := s.Set(key, severityName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Severity returns the severity of the rule.
+func (s Settings) Severity(r Rule) Severity {
+	if severity, ok := s[r]; ok {
+		return severity
+	}
+	return r.DefaultSeverity()
+}
@@ -0,0 +1,60 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsDefaultSeverity(t *testing.T) {
+	var s Settings
+	require.Equal(t, Error, s.Severity(VersionIncrement))
+	require.Equal(t, Warning, s.Severity(UnreleasedEmpty))
+}
+
+func TestSettingsParse(t *testing.T) {
+	s := make(Settings)
+	require.NoError(t, s.Parse("CLQ010=off, date-order = warning"))
+	require.Equal(t, Off, s.Severity(VersionIncrement))
+	require.Equal(t, Warning, s.Severity(DateOrder))
+	require.Equal(t, Error, s.Severity(VersionOrder))
+}
+
+func TestSettingsParseEmpty(t *testing.T) {
+	s := make(Settings)
+	require.NoError(t, s.Parse(""))
+	require.Empty(t, s)
+}
+
+func TestSettingsParseLastWins(t *testing.T) {
+	s := make(Settings)
+	require.NoError(t, s.Parse("CLQ010=off,version-increment=warning"))
+	require.Equal(t, Warning, s.Severity(VersionIncrement))
+}
+
+func TestSettingsParseMalformed(t *testing.T) {
+	s := make(Settings)
+	require.EqualError(t, s.Parse("CLQ010"), "\"CLQ010\" is not a rule=severity setting")
+}
+
+func TestSettingsSetUnknownRule(t *testing.T) {
+	s := make(Settings)
+	require.EqualError(t, s.Set("CLQ999", "off"), "unknown rule \"CLQ999\"")
+}
+
+func TestSettingsSetUnknownSeverity(t *testing.T) {
+	s := make(Settings)
+	require.EqualError(t, s.Set("CLQ010", "fatal"), "rule CLQ010 version-increment: \"fatal\" is not a valid severity")
+}
+
+func TestSettingsSetStructuralRule(t *testing.T) {
+	s := make(Settings)
+	require.EqualError(t, s.Set("heading-level", "warning"), "rule CLQ001 heading-level cannot be configured")
+	require.NoError(t, s.Set("heading-level", "error"))
+}
+
+func TestSettingsUpgradeWarning(t *testing.T) {
+	s := make(Settings)
+	require.NoError(t, s.Parse("unreleased-empty=error"))
+	require.Equal(t, Error, s.Severity(UnreleasedEmpty))
+}
@@ -0,0 +1,39 @@
+package rule
+
+import "fmt"
+
+// Severity tells how much a rule violation matters.
+type Severity int
+
+const (
+	Off Severity = iota
+	Warning
+	Error
+)
+
+// NewSeverity returns the severity with the given name.
+func NewSeverity(name string) (Severity, error) {
+	switch name {
+	case "off":
+		return Off, nil
+	case "warning":
+		return Warning, nil
+	case "error":
+		return Error, nil
+	default:
+		return Off, fmt.Errorf("%q is not a valid severity", name)
+	}
+}
+
+func (s Severity) String() string {
+	switch s {
+	case Off:
+		return "off"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	default:
+		panic(fmt.Sprintf("\"%d\" not defined", s))
+	}
+}
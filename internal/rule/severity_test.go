@@ -0,0 +1,30 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSeverity(t *testing.T) {
+	for _, s := range []Severity{Off, Warning, Error} {
+		severity, err := NewSeverity(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, severity)
+	}
+}
+
+func TestNewSeverityUnknown(t *testing.T) {
+	_, err := NewSeverity("fatal")
+	require.EqualError(t, err, "\"fatal\" is not a valid severity")
+}
+
+func TestSeverityString(t *testing.T) {
+	require.Equal(t, "off", Off.String())
+	require.Equal(t, "warning", Warning.String())
+	require.Equal(t, "error", Error.String())
+}
+
+func TestSeverityStringPanic(t *testing.T) {
+	require.PanicsWithValue(t, "\"42\" not defined", func() { _ = Severity(42).String() })
+}
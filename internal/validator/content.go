@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/config"
+	"github.com/denisa/clq/internal/rule"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// contentKinds names the kinds of block content validated in strict mode.
+var contentKinds = map[ast.NodeKind]string{
+	ast.KindBlockquote:      "blockquote",
+	ast.KindCodeBlock:       "code",
+	ast.KindFencedCodeBlock: "code",
+	ast.KindHTMLBlock:       "html",
+	ast.KindList:            "list",
+	ast.KindParagraph:       "paragraph",
+	ast.KindThematicBreak:   "thematic-break",
+}
+
+func (r *Validator) visitBlock(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		if err := r.validateContent(node); err != nil && r.fail(err) {
+			return r.stop()
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+// validateContent validates, in strict mode, that a block of the releases is either a list of change descriptions
+// or allowed content.
+func (r *Validator) validateContent(node ast.Node) error {
+	if !r.strict || node.Parent() == nil || node.Parent().Kind() != ast.KindDocument {
+		return nil
+	}
+	kind := contentKinds[node.Kind()]
+	switch {
+	case r.changelog.Release():
+		if r.allowedContent.IsAllowed(config.ReleaseScope, kind) {
+			return nil
+		}
+		return newError(rule.StrictContent, r.blockPosition(node), fmt.Errorf("validation error: %v not supported before the first change heading in strict mode %v", kind, r.changelog))
+	case r.changelog.Change():
+		if node.Kind() == ast.KindList || r.allowedContent.IsAllowed(config.ChangeScope, kind) {
+			return nil
+		}
+		return newError(rule.StrictContent, r.blockPosition(node), fmt.Errorf("validation error: %v not supported among the change descriptions in strict mode %v", kind, r.changelog))
+	default:
+		return nil
+	}
+}
+
+// blockPosition returns the position of the start of a block, like the opening fence of a fenced code block.
+func (r *Validator) blockPosition(node ast.Node) changelog.Position {
+	if node.Pos() < 0 {
+		return r.positionOf(node)
+	}
+	return r.positionAt(node.Pos())
+}
@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
+)
+
+// An Error is a violation of a validation rule, located in the changelog source.
+type Error struct {
+	Rule     rule.Rule
+	Severity rule.Severity
+	Position changelog.Position
+	Err      error
+	Related  Note
+}
+
+// A Note locates another place of the changelog source involved in an Error, like the first occurrence of a duplicate.
+// Its Position is not valid when there is no such place.
+type Note struct {
+	Position changelog.Position
+	Message  string
+}
+
+func newError(r rule.Rule, position changelog.Position, err error) Error {
+	return Error{Rule: r, Severity: rule.Error, Position: position, Err: err}
+}
+
+func (e Error) Error() string {
+	if !e.Position.IsValid() {
+		return e.Err.Error()
+	}
+	return e.Position.String() + ": " + e.Err.Error()
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
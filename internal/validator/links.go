@@ -0,0 +1,133 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/rule"
+	"github.com/yuin/goldmark/ast"
+)
+
+const unreleasedLabel = "Unreleased"
+
+// A linkDefinition is a link reference definition, like `[1.1.0]: https://host/compare/v1.0.0...v1.1.0`.
+type linkDefinition struct {
+	label    string
+	url      string
+	position changelog.Position
+}
+
+var compareRE = regexp.MustCompile(`/compare/([^/?#]+?)\.\.\.?([^/?#]+)`)
+
+// parseLinkDefinitions returns the link reference definitions parsed in the document, in order,
+// wherever goldmark accepts them, like in a block quote or a list item, but not in a code or an HTML block.
+func (r *Validator) parseLinkDefinitions(document ast.Node) []linkDefinition {
+	var result []linkDefinition
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if definition, ok := node.(*ast.LinkReferenceDefinition); ok && entering {
+			result = append(result, linkDefinition{label: strings.TrimSpace(string(definition.Label)), url: string(definition.Destination), position: r.positionAt(definition.Pos())})
+		}
+		return ast.WalkContinue, nil
+	})
+	return result
+}
+
+// releaseLabel returns the label under which a release is linked: its version, or Unreleased.
+func releaseLabel(release changelog.Release) string {
+	if !release.HasBeenReleased() {
+		return unreleasedLabel
+	}
+	return release.Version()
+}
+
+// normalizeLabel returns the release label named by a link label or a tag, ignoring any `v` prefix.
+// It returns false if the name is neither a version nor Unreleased.
+func normalizeLabel(name string) (string, bool) {
+	if strings.EqualFold(name, unreleasedLabel) {
+		return unreleasedLabel, true
+	}
+	version, err := changelog.NormalizeVersion(name)
+	if err != nil {
+		return "", false
+	}
+	return version, true
+}
+
+// validateLinks validates the link reference definitions of the releases, in the order of the releases.
+// The validation only applies to changelogs that define links for their versions.
+// It returns true if the validation must stop, false if it should proceed.
+func (r *Validator) validateLinks() bool {
+	definitions := make(map[string]linkDefinition)
+	var order []string
+	for _, definition := range r.linkDefinitions {
+		if label, ok := normalizeLabel(definition.label); ok {
+			if _, ok := definitions[label]; !ok {
+				definitions[label] = definition
+				order = append(order, label)
+			}
+		}
+	}
+	if len(definitions) == 0 {
+		return false
+	}
+
+	releases := make(map[string]int)
+	for i, release := range r.releases {
+		label := releaseLabel(release)
+		releases[label] = i
+		definition, ok := definitions[label]
+		if !ok {
+			if r.fail(newError(rule.LinkMissing, release.Position(), fmt.Errorf("release %q has no link reference definition", release.Title()))) {
+				return true
+			}
+			continue
+		}
+		if i+1 < len(r.releases) {
+			if err := validateCompare(definition, label, releaseLabel(r.releases[i+1])); err != nil && r.fail(err) {
+				return true
+			}
+		}
+	}
+
+	previous := -1
+	for _, label := range order {
+		definition := definitions[label]
+		i, ok := releases[label]
+		if !ok {
+			if r.fail(newError(rule.LinkOrphan, definition.position, fmt.Errorf("link reference definition [%v] matches no release", definition.label))) {
+				return true
+			}
+			continue
+		}
+		if i < previous {
+			if r.fail(newError(rule.LinkOrder, definition.position, fmt.Errorf("link reference definition [%v] should come before [%v]", definition.label, releaseLabel(r.releases[previous])))) {
+				return true
+			}
+			continue
+		}
+		previous = i
+	}
+	return false
+}
+
+// validateCompare validates that a compare URL goes from the previous release to this one.
+func validateCompare(definition linkDefinition, label string, previousLabel string) error {
+	matches := compareRE.FindStringSubmatch(definition.url)
+	if matches == nil {
+		return nil
+	}
+	from, _ := normalizeLabel(matches[1])
+	to, isVersion := normalizeLabel(matches[2])
+	if label == unreleasedLabel {
+		if from == previousLabel && !isVersion {
+			return nil
+		}
+		return newError(rule.LinkCompare, definition.position, fmt.Errorf("link reference definition [%v] compares %v...%v instead of %v...HEAD", definition.label, matches[1], matches[2], previousLabel))
+	}
+	if from == previousLabel && to == label {
+		return nil
+	}
+	return newError(rule.LinkCompare, definition.position, fmt.Errorf("link reference definition [%v] compares %v...%v instead of %v...%v", definition.label, matches[1], matches[2], previousLabel, label))
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/config"
+	"github.com/denisa/clq/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLabel(t *testing.T) {
+	for name, expected := range map[string]string{
+		"1.2.3":       "1.2.3",
+		"v1.2.3":      "1.2.3",
+		"1.2.3-rc.1":  "1.2.3-rc.1",
+		"v1.2.3+b.5":  "1.2.3+b.5",
+		"Unreleased":  unreleasedLabel,
+		"unreleased":  unreleasedLabel,
+		"HEAD":        "",
+		"1.2":         "",
+		"release-1.0": "",
+	} {
+		label, ok := normalizeLabel(name)
+		require.Equal(t, expected != "", ok, name)
+		require.Equal(t, expected, label, name)
+	}
+}
+
+func TestValidateCompare(t *testing.T) {
+	for _, testcase := range []struct {
+		label, previousLabel string
+		url                  string
+		valid                bool
+	}{
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/compare/v1.0.0...v1.1.0", true},
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/compare/1.0.0..1.1.0", true},
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/compare/v1.0.0...v1.1.0?diff=split", true},
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/releases/tag/v1.1.0", true},
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/compare/v0.9.0...v1.1.0", false},
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/compare/v1.0.0...v1.2.0", false},
+		{"1.1.0", "1.0.0", "https://github.com/denisa/clq/compare/v1.0.0...HEAD", false},
+		{unreleasedLabel, "1.1.0", "https://github.com/denisa/clq/compare/v1.1.0...HEAD", true},
+		{unreleasedLabel, "1.1.0", "https://github.com/denisa/clq/compare/v1.1.0...main", true},
+		{unreleasedLabel, "1.1.0", "https://github.com/denisa/clq/compare/v1.1.0...v1.2.0", false},
+		{unreleasedLabel, "1.1.0", "https://github.com/denisa/clq/compare/v1.0.0...HEAD", false},
+	} {
+		definition := linkDefinition{label: testcase.label, url: testcase.url, position: changelog.Position{Line: 9, Column: 1}}
+		err := validateCompare(definition, testcase.label, testcase.previousLabel)
+		if testcase.valid {
+			require.NoError(t, err, testcase.url)
+			continue
+		}
+		require.Error(t, err, testcase.url)
+		require.Equal(t, rule.LinkCompare, err.(Error).Rule, testcase.url)
+	}
+}
+
+func TestValidatorLinks(t *testing.T) {
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [Unreleased]",
+		"### Fixed",
+		"- foo",
+		"## [1.1.0] - 2020-03-01",
+		"### Changed",
+		"- bar",
+		"## [1.0.0] - 2020-02-01",
+		"### Added",
+		"- baz",
+		"",
+		"[unreleased]: https://github.com/denisa/clq/compare/v1.1.0...HEAD",
+		"[1.1.0]: https://github.com/denisa/clq/compare/v1.0.0...v1.1.0",
+		"[1.0.0]: https://github.com/denisa/clq/releases/tag/v1.0.0",
+	})
+	require.Empty(t, violations)
+}
+
+func TestValidatorLinksViolations(t *testing.T) {
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [1.2.0] - 2020-04-01",
+		"### Changed",
+		"- qux",
+		"## [1.1.0] - 2020-03-01",
+		"### Changed",
+		"- bar",
+		"## [1.0.0] - 2020-02-01",
+		"### Added",
+		"- baz",
+		"",
+		"[1.0.0]: https://github.com/denisa/clq/releases/tag/v1.0.0",
+		"[1.1.0]: https://github.com/denisa/clq/compare/v0.9.0...v1.1.0",
+		"[0.9.0]: https://github.com/denisa/clq/releases/tag/v0.9.0",
+	}, config.WithAllErrors(true))
+	require.Equal(t, []string{rule.LinkMissing.ID, rule.LinkCompare.ID, rule.LinkOrder.ID, rule.LinkOrphan.ID}, rulesOf(violations))
+	require.Equal(t, changelog.Position{Line: 2, Column: 4}, violations[0].Position)
+	require.Equal(t, changelog.Position{Line: 13, Column: 1}, violations[1].Position)
+}
+
+func TestValidatorLinksInCodeBlockIgnored(t *testing.T) {
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [1.0.0] - 2020-02-01",
+		"### Added",
+		"- baz",
+		"",
+		"```",
+		"[0.9.0]: https://github.com/denisa/clq/releases/tag/v0.9.0",
+		"```",
+	}, config.WithStrict(false, nil))
+	require.Empty(t, violations)
+}
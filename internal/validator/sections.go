@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/denisa/clq/internal/rule"
+	"github.com/yuin/goldmark/ast"
+)
+
+// visitSection enters a level 2 heading that is a section rather than a release.
+// The first section after the releases starts the footer of the changelog, after which no release may come.
+func (r *Validator) visitSection(node ast.Node, title string) (ast.WalkStatus, error) {
+	position := r.positionOf(node)
+	section, err := r.headingsFactory.NewSection(title, position, r.sectionContent(node))
+	if err != nil {
+		return r.abort(newError(rule.ReleaseHeading, position, err))
+	}
+	if err := r.changelog.EnterSection(section); err != nil {
+		return r.abort(newError(rule.ReleaseHeading, position, err))
+	}
+	if len(r.releases) > 0 && r.footer == "" {
+		r.footer = section.Title()
+	}
+	return ast.WalkContinue, nil
+}
+
+// sectionContent returns the markdown content of a section as written in the source, from the block following
+// its heading up to the next heading of the same or a higher level, or up to the link reference definitions
+// of the releases.
+func (r *Validator) sectionContent(node ast.Node) string {
+	if isEmptySection(node) {
+		return ""
+	}
+	start := r.lineStarts[r.blockPosition(node.NextSibling()).Line-1]
+	end := len(r.source)
+	for sibling := node.NextSibling(); sibling != nil; sibling = sibling.NextSibling() {
+		if heading, ok := sibling.(*ast.Heading); ok && heading.Level <= 2 {
+			end = r.lineStarts[r.positionOf(heading).Line-1]
+			break
+		}
+	}
+	for _, definition := range r.linkDefinitions {
+		if _, ok := normalizeLabel(definition.label); !ok {
+			continue
+		}
+		if offset := r.lineStarts[definition.position.Line-1]; offset >= start && offset < end {
+			end = offset
+			break
+		}
+	}
+	return strings.TrimRight(string(r.source[start:end]), " \t\r\n")
+}
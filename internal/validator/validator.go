@@ -2,41 +2,116 @@ package validator
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/denisa/clq/internal/changelog"
 	"github.com/denisa/clq/internal/config"
+	"github.com/denisa/clq/internal/rule"
 	"github.com/denisa/clq/internal/semver"
+	east "github.com/yuin/goldmark-emoji/ast"
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/util"
 )
 
+// maxDescriptionLength is the length, in characters, above which a change description is too long.
+const maxDescriptionLength = 300
+
 // A Validator struct is an implementation of renderer.NodeRenderer that validates
 // a changelog.
 type Validator struct {
 	release                  bool
+	allErrors                bool
+	warningsAsErrors         bool
+	rules                    rule.Settings
+	today                    time.Time
+	checkReleaseAge          bool
+	maxReleaseAge            int
+	expectedVersion          string
+	expectedDate             string
+	acrossReleases           bool
+	strict                   bool
+	allowedContent           config.ContentAllowList
+	groups                   bool
+	scopes                   []string
+	sections                 config.SectionTitles
+	footer                   string
+	emoji                    config.EmojiPolicy
+	changePolicy             config.ChangePolicy
+	errors                   []error
+	source                   []byte
+	lineStarts               []int
+	linkDefinitions          []linkDefinition
 	changeKind               *changelog.ChangeKind
+	headingsFactory          changelog.HeadingsFactory
+	descriptionRulesFor      func(kind string) changelog.DescriptionRules
+	descriptionRules         changelog.DescriptionRules
 	text                     strings.Builder
 	hasIntroductionHeading   bool
 	h1Released, h1Unreleased bool
 	changes                  changelog.ChangeMap
 	hasChangeDescriptions    bool
+	descriptions             map[string]changelog.Position
+	orderedChanges           []changelog.Change
+	changeMisplaced          bool
+	changeGroups             map[string]bool
+	items                    []pendingItem
 	changelog                *changelog.Changelog
 	previousRelease          changelog.Release
+	releases                 []changelog.Release
+	policyRelease            int
+	group                    versionGroup
+	pendingGroups            []versionGroup
+}
+
+// A pendingItem is a change description whose nested change descriptions are being visited.
+type pendingItem struct {
+	text     string
+	hasText  bool
+	position changelog.Position
+	markdown string
+	items    []changelog.ChangeItem
+}
+
+// A versionGroup collects the changes of consecutive releases that share their version core,
+// like 1.2.0, 1.2.0-rc.2 and 1.2.0-rc.1.
+type versionGroup struct {
+	release changelog.Release
+	changes changelog.ChangeMap
 }
 
 func NewValidator(config config.Config) renderer.NodeRenderer {
 	hf := changelog.NewHeadingFactory(config.ChangeKind())
 
 	r := &Validator{
-		release:    config.IsRelease(),
-		changeKind: config.ChangeKind(),
-		changes:    make(changelog.ChangeMap),
-		changelog:  changelog.NewChangelog(hf),
+		release:             config.IsRelease(),
+		allErrors:           config.IsAllErrors(),
+		warningsAsErrors:    config.IsWarningsAsErrors(),
+		acrossReleases:      config.IsDuplicatesAcrossReleases(),
+		rules:               config.Rules(),
+		today:               config.Today(),
+		changeKind:          config.ChangeKind(),
+		emoji:               config.Emoji(),
+		changePolicy:        config.ChangePolicy(),
+		headingsFactory:     hf,
+		descriptionRulesFor: config.DescriptionRules,
+		changes:             make(changelog.ChangeMap),
+		descriptions:        make(map[string]changelog.Position),
+		changelog:           changelog.NewChangelog(hf),
 	}
 
+	r.checkReleaseAge, r.maxReleaseAge = config.MaxReleaseAge()
+	r.expectedVersion, r.expectedDate = config.ExpectedVersion(), config.ExpectedDate()
+	r.strict, r.allowedContent = config.Strict()
+	r.groups, r.scopes = config.Groups()
+	r.sections = config.Sections()
+
 	if ok, listeners := config.Listeners(); ok {
 		r.changelog.Listener(listeners)
 	}
@@ -49,26 +124,101 @@ func (r *Validator) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindHeading, r.visitHeading)
 	reg.Register(ast.KindList, r.visitList)
 	reg.Register(ast.KindListItem, r.visitListItem)
+	reg.Register(ast.KindBlockquote, r.visitBlock)
+	reg.Register(ast.KindCodeBlock, r.visitBlock)
+	reg.Register(ast.KindFencedCodeBlock, r.visitBlock)
+	reg.Register(ast.KindHTMLBlock, r.visitBlock)
+	reg.Register(ast.KindParagraph, r.visitBlock)
+	reg.Register(ast.KindThematicBreak, r.visitBlock)
 
 	reg.Register(ast.KindAutoLink, r.visitAutoLink)
+	reg.Register(east.KindEmoji, r.visitEmoji)
 	reg.Register(ast.KindImage, r.visitImage)
 	reg.Register(ast.KindLink, r.visitLink)
 	reg.Register(ast.KindText, r.visitText)
 }
 
-func (r *Validator) visitDocument(_ util.BufWriter, _ []byte, _ ast.Node, entering bool) (ast.WalkStatus, error) {
-	if !entering {
-		if !r.h1Released && !r.h1Unreleased {
-			return ast.WalkStop, fmt.Errorf("validation error: No release defined in changelog")
+func (r *Validator) visitDocument(_ util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		r.source = source
+		r.lineStarts = append(r.lineStarts[:0], 0)
+		for i, c := range source {
+			if c == '\n' {
+				r.lineStarts = append(r.lineStarts, i+1)
+			}
+		}
+		r.linkDefinitions = r.parseLinkDefinitions(node)
+	} else {
+		if !r.h1Released && (!r.h1Unreleased || r.release) && r.fail(newError(rule.ReleaseMissing, r.changelog.Position(), fmt.Errorf("validation error: No release defined in changelog"))) {
+			return r.stop()
+		}
+		if (r.expectedVersion != "" || r.expectedDate != "") && !r.hasRelease() && r.fail(newError(rule.ExpectedRelease, r.changelog.Position(), fmt.Errorf("no release to match the expected version or date"))) {
+			return r.stop()
+		}
+		if err := r.validateChangeDescriptions(); err != nil && r.fail(err) {
+			return r.stop()
 		}
-		if (r.changelog.Release() || r.changelog.Change()) && !r.hasChangeDescriptions {
-			return ast.WalkStop, fmt.Errorf("no change descriptions for %v", r.changelog)
+		if r.validateLinks() {
+			return r.stop()
 		}
 		r.changelog.Close()
+		if len(r.errors) > 0 {
+			return r.stop()
+		}
 	}
 	return ast.WalkContinue, nil
 }
 
+// fail records a recoverable validation error, with the severity configured for its rule.
+// It returns true if the validation must stop, false if it should proceed to collect further errors.
+func (r *Validator) fail(err error) bool {
+	e, ok := err.(Error)
+	if !ok {
+		r.errors = append(r.errors, err)
+		return !r.allErrors
+	}
+	e.Severity = r.rules.Severity(e.Rule)
+	if e.Severity == rule.Off {
+		return false
+	}
+	if e.Severity == rule.Warning && r.warningsAsErrors {
+		e.Severity = rule.Error
+	}
+	r.errors = append(r.errors, e)
+	return e.Severity == rule.Error && !r.allErrors
+}
+
+// abort records an unrecoverable validation error and stops the validation.
+func (r *Validator) abort(err error) (ast.WalkStatus, error) {
+	r.errors = append(r.errors, err)
+	return r.stop()
+}
+
+// stop stops the validation, reporting all the errors collected so far.
+func (r *Validator) stop() (ast.WalkStatus, error) {
+	return ast.WalkStop, errors.Join(r.errors...)
+}
+
+// positionOf returns the position of the node’s content in the source.
+func (r *Validator) positionOf(node ast.Node) changelog.Position {
+	offset := node.Pos()
+	if lines := node.Lines(); lines.Len() > 0 {
+		offset = lines.At(0).Start
+	} else if child := node.FirstChild(); child != nil && child.Type() == ast.TypeBlock && child.Lines().Len() > 0 {
+		offset = child.Lines().At(0).Start
+	}
+	return r.positionAt(offset)
+}
+
+// positionAt returns the position of an offset in the source.
+func (r *Validator) positionAt(offset int) changelog.Position {
+	if offset < 0 {
+		return changelog.Position{}
+	}
+	line := sort.Search(len(r.lineStarts), func(i int) bool { return r.lineStarts[i] > offset })
+	return changelog.Position{Line: line, Column: offset - r.lineStarts[line-1] + 1}
+}
+
 func (r *Validator) visitHeading(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
 		r.text.Reset()
@@ -76,66 +226,84 @@ func (r *Validator) visitHeading(_ util.BufWriter, _ []byte, node ast.Node, ente
 	}
 
 	n := node.(*ast.Heading)
+	position := r.positionOf(n)
 	if !r.hasIntroductionHeading && n.Level > 1 {
-		return ast.WalkStop, fmt.Errorf("validation error: Introduction’s title must be defined")
+		return r.abort(newError(rule.IntroductionTitle, position, fmt.Errorf("validation error: Introduction’s title must be defined")))
+	}
+
+	if n.Level > 2 && r.changelog.InSection() {
+		return ast.WalkContinue, nil
+	}
+	if n.Level == 4 && r.groups {
+		return r.visitHeading4(position)
 	}
 
 	switch n.Level {
 	default:
-		return ast.WalkStop, fmt.Errorf("validation error: Heading level %d not supported", n.Level)
+		return r.abort(newError(rule.HeadingLevel, position, fmt.Errorf("validation error: Heading level %d not supported", n.Level)))
 	case 1:
-		return r.visitHeading1()
+		return r.visitHeading1(position)
 	case 2:
-		return r.visitHeading2()
+		return r.visitHeading2(n, position)
 	case 3:
-		return r.visitHeading3()
+		return r.visitHeading3(position)
 	}
 }
 
-func (r *Validator) visitHeading1() (ast.WalkStatus, error) {
-	_, err := r.changelog.Section(changelog.IntroductionHeading, r.text.String())
+func (r *Validator) visitHeading1(position changelog.Position) (ast.WalkStatus, error) {
+	_, err := r.changelog.Section(changelog.IntroductionHeading, r.text.String(), position)
 	if err != nil {
-		return ast.WalkStop, err
+		return r.abort(newError(rule.IntroductionTitle, position, err))
 	}
 	r.hasIntroductionHeading = true
 	return ast.WalkContinue, nil
 }
 
-//gocyclo:ignore
-func (r *Validator) visitHeading2() (ast.WalkStatus, error) {
-	if (r.changelog.Release() || r.changelog.Change()) && !r.hasChangeDescriptions {
-		return ast.WalkStop, fmt.Errorf("no change descriptions for %v", r.changelog)
+func (r *Validator) visitHeading2(node ast.Node, position changelog.Position) (ast.WalkStatus, error) {
+	if err := r.validateChangeDescriptions(); err != nil && r.fail(err) {
+		return r.stop()
 	}
-	h, err := r.changelog.Section(changelog.ReleaseHeading, r.text.String())
+	if title := r.text.String(); r.sections.Matches(title) {
+		return r.visitSection(node, title)
+	}
+	h, err := r.changelog.Section(changelog.ReleaseHeading, r.text.String(), position)
 	if err != nil {
-		return ast.WalkStop, err
+		return r.abort(newError(rule.ReleaseHeading, position, err))
 	}
 
 	release := h.(changelog.Release)
-	if err := r.validateReleaseHeading(release); err != nil {
-		return ast.WalkStop, err
-	}
-
-	if r.previousRelease.IsRelease() && release.IsRelease() {
-		changeKind := r.changeKind
-		increment, trigger := changeKind.IncrementFor(r.changes)
-		if increment == semver.Build {
-			return ast.WalkStop, fmt.Errorf("release %q cannot have only build-level changes because it is not the initial release", r.previousRelease.Title())
-		}
-		if err := r.previousRelease.IsNewerThan(release); err != nil {
-			return ast.WalkStop, err
-		}
-		nextRelease := release.NextRelease(increment)
-		if !r.previousRelease.ReleaseIs(nextRelease) {
-			if release.IsMajorVersionZero() && increment == semver.Major {
-				nextMinorRelease := release.NextRelease(semver.Minor)
-				if !r.previousRelease.ReleaseIs(nextMinorRelease) {
-					return ast.WalkStop, fmt.Errorf("release %q should have version %v or %v because of %q", r.previousRelease.Title(), nextMinorRelease, nextRelease, trigger)
-				}
-			} else {
-				return ast.WalkStop, fmt.Errorf("release %q should have version %v because of %q", r.previousRelease.Title(), nextRelease, trigger)
-			}
+	if err := r.validateReleaseHeading(release, isEmptySection(node)); err != nil && r.fail(err) {
+		return r.stop()
+	}
+	if err := r.validateReplacement(release); err != nil && r.fail(err) {
+		return r.stop()
+	}
+	if r.footer != "" && r.fail(newError(rule.SectionOrder, position, fmt.Errorf("release %q should come before the section %q", release.Title(), r.footer))) {
+		return r.stop()
+	}
+
+	if err := release.IsNotInTheFuture(r.today); err != nil && r.fail(newError(rule.ReleaseDateFuture, position, err)) {
+		return r.stop()
+	}
+
+	if r.release && r.checkReleaseAge && r.isMostRecentRelease(release) {
+		if err := release.IsNotOlderThanDays(r.today, r.maxReleaseAge); err != nil && r.fail(newError(rule.ReleaseAge, position, err)) {
+			return r.stop()
+		}
+	}
+
+	if r.isMostRecentRelease(release) {
+		if err := r.validateExpectedRelease(release); err != nil && r.fail(err) {
+			return r.stop()
+		}
+	}
+
+	if r.previousRelease.HasBeenReleased() && release.HasBeenReleased() {
+		if err := r.validateReleaseIncrement(release); err != nil && r.fail(err) {
+			return r.stop()
 		}
+	} else {
+		r.group = versionGroup{release: release, changes: make(changelog.ChangeMap)}
 	}
 
 	if !release.HasBeenReleased() {
@@ -145,60 +313,290 @@ func (r *Validator) visitHeading2() (ast.WalkStatus, error) {
 	}
 	r.hasChangeDescriptions = false
 	r.changes = make(changelog.ChangeMap)
+	r.orderedChanges, r.changeMisplaced = nil, false
+	if !r.acrossReleases {
+		r.descriptions = make(map[string]changelog.Position)
+	}
 	r.previousRelease = release
+	r.releases = append(r.releases, release)
 	return ast.WalkContinue, nil
 }
 
-func (r *Validator) validateReleaseHeading(release changelog.Release) error {
+// validateReplacement validates that the version replacing a yanked release is the one of a newer release.
+func (r *Validator) validateReplacement(release changelog.Release) error {
+	replacement := release.Replacement()
+	if replacement == "" {
+		return nil
+	}
+	if !release.HasNewerReplacement() {
+		return newError(rule.YankedReplacement, release.Position(), fmt.Errorf("release %q should be replaced by a version newer than %v", release.Title(), release.Version()))
+	}
+	for _, newer := range r.releases {
+		if newer.Version() == replacement {
+			return nil
+		}
+	}
+	return newError(rule.YankedReplacement, release.Position(), fmt.Errorf("release %q is replaced by %v, which is not a newer release of the changelog", release.Title(), replacement))
+}
+
+// validateChangeDescriptions validates that the release, or the change, being closed has change descriptions.
+func (r *Validator) validateChangeDescriptions() error {
+	switch {
+	case r.hasChangeDescriptions:
+		return nil
+	case r.changelog.Release() && !r.previousRelease.HasBeenReleased():
+		if r.release {
+			return nil
+		}
+		return newError(rule.UnreleasedEmpty, r.changelog.Position(), fmt.Errorf("no changes for %v", r.changelog))
+	case r.changelog.Release() || r.changelog.Change():
+		return newError(rule.ChangeDescriptionsMissing, r.changelog.Position(), fmt.Errorf("no change descriptions for %v", r.changelog))
+	default:
+		return nil
+	}
+}
+
+// validateReleaseHeading validates the position of the release among the others.
+// In release mode, an "[Unreleased]" must stay empty.
+func (r *Validator) validateReleaseHeading(release changelog.Release, empty bool) error {
 	if release.HasBeenReleased() {
 		if release.HasBeenYanked() {
+			if !r.h1Released && r.release {
+				return newError(rule.YankedFirst, release.Position(), fmt.Errorf("validation error: Changelog cannot start with a \"[YANKED]\" release in release mode, insert a release first %v", r.changelog))
+			}
 			if !r.h1Released && !r.h1Unreleased {
-				return fmt.Errorf("validation error: Changelog cannot start with a \"[YANKED]\" release, insert a release or a \"[Unreleased]\" first %v", r.changelog)
+				return newError(rule.YankedFirst, release.Position(), fmt.Errorf("validation error: Changelog cannot start with a \"[YANKED]\" release, insert a release or a \"[Unreleased]\" first %v", r.changelog))
 			}
 		}
 	} else {
-		if r.release {
-			return fmt.Errorf("validation error: \"[Unreleased]\" not supported in release mode %v", r.changelog)
+		if r.release && !empty {
+			return newError(rule.ReleaseModeUnreleased, release.Position(), fmt.Errorf("validation error: \"[Unreleased]\" not supported in release mode %v", r.changelog))
 		}
 		if r.h1Unreleased {
-			return fmt.Errorf("validation error: Multiple \"[Unreleased]\" not supported %v", r.changelog)
+			return newError(rule.UnreleasedMultiple, release.Position(), fmt.Errorf("validation error: Multiple \"[Unreleased]\" not supported %v", r.changelog))
 		}
 		if r.h1Released {
-			return fmt.Errorf("validation error: \"[Unreleased]\" must come before any release %v", r.changelog)
+			return newError(rule.UnreleasedFirst, release.Position(), fmt.Errorf("validation error: \"[Unreleased]\" must come before any release %v", r.changelog))
 		}
 	}
 	return nil
 }
 
-func (r *Validator) visitHeading3() (ast.WalkStatus, error) {
+// isMostRecentRelease returns true if the release is the first one to have been released.
+func (r *Validator) isMostRecentRelease(release changelog.Release) bool {
+	return release.HasBeenReleased() && !r.hasRelease()
+}
+
+// hasRelease returns true if any release seen so far has been released.
+func (r *Validator) hasRelease() bool {
+	for _, release := range r.releases {
+		if release.HasBeenReleased() {
+			return true
+		}
+	}
+	return false
+}
+
+// validateExpectedRelease validates that the most recent release has the expected version and date, if any.
+func (r *Validator) validateExpectedRelease(release changelog.Release) error {
+	if r.expectedVersion != "" && release.Version() != r.expectedVersion {
+		return newError(rule.ExpectedRelease, release.Position(), fmt.Errorf("release %q should have version %v", release.Title(), r.expectedVersion))
+	}
+	if r.expectedDate != "" && release.Date() != r.expectedDate {
+		return newError(rule.ExpectedRelease, release.Position(), fmt.Errorf("release %q should be dated %v", release.Title(), r.expectedDate))
+	}
+	return nil
+}
+
+// validateReleaseIncrement validates that the previous, newer, release follows this release.
+// The changes of the releases of a versionGroup roll up: when this release is a final release, the
+// version increment of every group since is justified by the changes of that group and of the older ones.
+func (r *Validator) validateReleaseIncrement(release changelog.Release) error {
+	r.group.changes.Add(r.changes)
+	sameCore := r.previousRelease.HasSameCore(release)
+	var groups []versionGroup
+	if !sameCore {
+		r.pendingGroups = append(r.pendingGroups, r.group)
+		r.group = versionGroup{release: release, changes: make(changelog.ChangeMap)}
+		if !release.HasPrerelease() {
+			groups, r.pendingGroups = r.pendingGroups, nil
+		}
+	}
+
+	// The releases of a version only differing by their build metadata have the same precedence, in no particular order.
+	sameBuild := r.previousRelease.Version() == release.Version()
+	if sameCore && r.previousRelease.HasSamePrerelease(release) {
+		increment, trigger := r.changeKind.IncrementFor(r.changes)
+		if increment == semver.Build && sameBuild {
+			return newError(rule.BuildOnly, r.previousRelease.Position(), fmt.Errorf("release %q cannot have only build-level changes because it is not the initial release", r.previousRelease.Title()))
+		}
+		if increment != semver.Build && !sameBuild {
+			return newError(rule.BuildIncrement, r.previousRelease.Position(), fmt.Errorf("release %q only differs from %q by its build metadata but has %q changes", r.previousRelease.Title(), release.Title(), trigger))
+		}
+	}
+	if err := r.previousRelease.IsNotOlderThan(release); err != nil {
+		return newError(rule.DateOrder, release.Position(), err)
+	}
+	if sameCore && r.previousRelease.HasSamePrerelease(release) && !sameBuild {
+		return nil
+	}
+	if err := r.previousRelease.SortsAfter(release); err != nil {
+		return newError(rule.VersionOrder, release.Position(), err)
+	}
+	if sameCore {
+		return nil
+	}
+
+	changes := make(changelog.ChangeMap)
+	for i := len(groups) - 1; i >= 0; i-- {
+		changes.Add(groups[i].changes)
+		if err := r.validateGroupIncrement(release, groups[i].release, changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGroupIncrement validates that the version increment from a final release to a newer release is justified by the changes.
+func (r *Validator) validateGroupIncrement(release changelog.Release, newer changelog.Release, changes changelog.ChangeMap) error {
+	increment, trigger := r.changeKind.IncrementFor(changes)
+	if increment == semver.Build {
+		return newError(rule.BuildOnly, newer.Position(), fmt.Errorf("release %q cannot have only build-level changes because it is not the initial release", newer.Title()))
+	}
+	nextRelease := release.NextRelease(increment)
+	if newer.CoreIs(nextRelease) {
+		return nil
+	}
+	if release.IsMajorVersionZero() && increment == semver.Major {
+		nextMinorRelease := release.NextRelease(semver.Minor)
+		if !newer.CoreIs(nextMinorRelease) {
+			return newError(rule.VersionIncrement, newer.Position(), fmt.Errorf("release %q should have version %v or %v because of %q", newer.Title(), nextMinorRelease, nextRelease, trigger))
+		}
+		return nil
+	}
+	return newError(rule.VersionIncrement, newer.Position(), fmt.Errorf("release %q should have version %v because of %q", newer.Title(), nextRelease, trigger))
+}
+
+func (r *Validator) visitHeading3(position changelog.Position) (ast.WalkStatus, error) {
 	if r.changelog.Introduction() {
-		return ast.WalkStop, fmt.Errorf("changes must be in a release %v", r.changelog)
+		return r.abort(newError(rule.ChangeOutsideRelease, position, fmt.Errorf("changes must be in a release %v", r.changelog)))
 	}
-	if r.changelog.Change() && !r.hasChangeDescriptions {
-		return ast.WalkStop, fmt.Errorf("no change descriptions for %v", r.changelog)
+	if r.changelog.Change() && !r.hasChangeDescriptions && r.fail(newError(rule.ChangeDescriptionsMissing, r.changelog.Position(), fmt.Errorf("no change descriptions for %v", r.changelog))) {
+		return r.stop()
 	}
 
-	h, err := r.changelog.Section(changelog.ChangeHeading, r.text.String())
+	h, err := r.changelog.Section(changelog.ChangeHeading, r.text.String(), position)
 	if err != nil {
-		return ast.WalkStop, err
+		return r.abort(newError(rule.ChangeHeading, position, err))
 	}
 
 	change := h.(changelog.Change)
-	if err := r.validateChangeHeading(change); err != nil {
-		return ast.WalkStop, err
+	if err := r.validateChangeHeading(change); err != nil && r.fail(err) {
+		return r.stop()
+	}
+	if err := r.validateChangeOrder(change); err != nil && r.fail(err) {
+		return r.stop()
 	}
+	if err := r.validateChangeEmoji(change); err != nil && r.fail(err) {
+		return r.stop()
+	}
+	if r.policyRelease == 0 {
+		r.policyRelease = len(r.releases)
+	}
+	if r.policyRelease == len(r.releases) {
+		if err := r.validateChangePolicy(change); err != nil && r.fail(err) {
+			return r.stop()
+		}
+	}
+	r.descriptionRules = r.descriptionRulesFor(change.Title())
 	r.hasChangeDescriptions = false
+	r.changeGroups = make(map[string]bool)
 	return ast.WalkContinue, nil
 }
 
 func (r *Validator) validateChangeHeading(change changelog.Change) error {
 	if r.changes[change.Title()] {
-		return fmt.Errorf("validation error: Multiple headings %q not supported %v", change.Title(), r.changelog)
+		return newError(rule.ChangeHeadingDuplicate, change.Position(), fmt.Errorf("validation error: Multiple headings %q not supported %v", change.Title(), r.changelog))
 	}
 	r.changes[change.Title()] = true
 	return nil
 }
 
+// validateChangeOrder validates that the change headings of a release follow the order of their change kinds.
+// Only the first misplaced heading of a release is reported.
+func (r *Validator) validateChangeOrder(change changelog.Change) error {
+	order, ok := r.changeKind.Order(change.Title())
+	if !ok || r.changeMisplaced {
+		return nil
+	}
+	for _, previous := range r.orderedChanges {
+		if previousOrder, _ := r.changeKind.Order(previous.Title()); previousOrder > order {
+			r.changeMisplaced = true
+			return newError(rule.ChangeOrder, change.Position(), fmt.Errorf("change heading %q should come before %q %v", change.Title(), previous.Title(), r.changelog))
+		}
+	}
+	r.orderedChanges = append(r.orderedChanges, change)
+	return nil
+}
+
+// validateChangeEmoji validates that a change heading starts with the emoji of its change kind, or not, as configured.
+func (r *Validator) validateChangeEmoji(change changelog.Change) error {
+	switch {
+	case r.emoji == config.EmojiRequired && change.Emoji() != "" && !change.HasEmoji():
+		return newError(rule.ChangeEmoji, change.Position(), fmt.Errorf("change heading %q should start with its emoji %v %v", change.Title(), change.Emoji(), r.changelog))
+	case r.emoji == config.EmojiForbidden && change.HasEmoji():
+		return newError(rule.ChangeEmoji, change.Position(), fmt.Errorf("change heading %q should not start with an emoji %v", change.Title(), r.changelog))
+	default:
+		return nil
+	}
+}
+
+// validateChangePolicy validates a change heading of the first release with changes, usually the [Unreleased]
+// or the most recent release, against the restrictions on the version increment and on the change kinds.
+func (r *Validator) validateChangePolicy(change changelog.Change) error {
+	if r.changePolicy.IsForbidden(change.Title()) {
+		return newError(rule.ChangeForbidden, change.Position(), fmt.Errorf("change heading %q is forbidden %v", change.Title(), r.changelog))
+	}
+	increment, _ := r.changeKind.IncrementFor(changelog.ChangeMap{change.Title(): true})
+	if maxIncrement := r.changePolicy.MaxIncrement(); increment < maxIncrement {
+		return newError(rule.MaxIncrement, change.Position(), fmt.Errorf("change heading %q requires a %v increment, more than the maximum %v increment %v", change.Title(), increment, maxIncrement, r.changelog))
+	}
+	return nil
+}
+
+func (r *Validator) visitHeading4(position changelog.Position) (ast.WalkStatus, error) {
+	if !r.changelog.Change() {
+		return r.abort(newError(rule.GroupOutsideChange, position, fmt.Errorf("groups must be in a change %v", r.changelog)))
+	}
+	if r.changelog.Group() && !r.hasChangeDescriptions && r.fail(newError(rule.ChangeDescriptionsMissing, r.changelog.Position(), fmt.Errorf("no change descriptions for %v", r.changelog))) {
+		return r.stop()
+	}
+
+	h, err := r.changelog.Section(changelog.GroupHeading, r.text.String(), position)
+	if err != nil {
+		return r.abort(newError(rule.GroupHeading, position, err))
+	}
+
+	group := h.(changelog.Group)
+	if err := r.validateGroupHeading(group); err != nil && r.fail(err) {
+		return r.stop()
+	}
+	r.hasChangeDescriptions = false
+	return ast.WalkContinue, nil
+}
+
+// validateGroupHeading validates that the group is unique within its change and one of the configured scopes, if any.
+func (r *Validator) validateGroupHeading(group changelog.Group) error {
+	if r.changeGroups[group.Title()] {
+		return newError(rule.GroupHeadingDuplicate, group.Position(), fmt.Errorf("validation error: Multiple groups %q not supported %v", group.Title(), r.changelog))
+	}
+	r.changeGroups[group.Title()] = true
+	if len(r.scopes) == 0 || slices.Contains(r.scopes, group.Title()) {
+		return nil
+	}
+	return newError(rule.GroupScope, group.Position(), fmt.Errorf("group %q should be one of %v %v", group.Title(), strings.Join(r.scopes, ", "), r.changelog))
+}
+
 func (r *Validator) visitAutoLink(_ util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.AutoLink)
 	if entering {
@@ -215,6 +613,9 @@ func (r *Validator) visitAutoLink(_ util.BufWriter, source []byte, node ast.Node
 
 func (r *Validator) visitLink(w util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.Link)
+	if n.Reference != nil && isInHeading(n) {
+		return r.visitReferenceLink(n, entering)
+	}
 	if entering {
 		r.text.WriteString("[")
 	} else {
@@ -231,25 +632,152 @@ func (r *Validator) visitLink(w util.BufWriter, _ []byte, node ast.Node, enterin
 	return ast.WalkContinue, nil
 }
 
-func (r *Validator) visitList(_ util.BufWriter, _ []byte, _ ast.Node, _ bool) (ast.WalkStatus, error) {
+// isEmptySection returns true if no content follows the heading before the next heading of the same or a higher level.
+func isEmptySection(node ast.Node) bool {
+	sibling := node.NextSibling()
+	if sibling == nil {
+		return true
+	}
+	heading, ok := sibling.(*ast.Heading)
+	return ok && heading.Level <= node.(*ast.Heading).Level
+}
+
+// visitReferenceLink writes a reference link as in the source, so that a release heading
+// like `## [1.1.0] - 2020-02-29` keeps its version even when a link reference defines `[1.1.0]`.
+func (r *Validator) visitReferenceLink(n *ast.Link, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		r.text.WriteString("[")
+	} else {
+		r.text.WriteString("]")
+		if n.Reference.Type == ast.ReferenceLinkFull {
+			r.text.WriteString("[")
+			r.text.Write(n.Reference.Value)
+			r.text.WriteString("]")
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+// isInHeading returns true if the node is part of a heading.
+func isInHeading(node ast.Node) bool {
+	return headingOf(node) != nil
+}
+
+// headingOf returns the heading the node is part of, if any.
+func headingOf(node ast.Node) *ast.Heading {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		if heading, ok := parent.(*ast.Heading); ok {
+			return heading
+		}
+	}
+	return nil
+}
+
+// visitEmoji writes an emoji shortcode as its emoji in a change heading, so that `### :sparkles: Added`
+// reads like `### ✨ Added`, and as in the source anywhere else.
+func (r *Validator) visitEmoji(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		n := node.(*east.Emoji)
+		if heading := headingOf(n); heading != nil && heading.Level == 3 {
+			r.text.WriteString(string(n.Value.Unicode))
+		} else {
+			r.text.WriteString(":" + string(n.ShortName) + ":")
+		}
+	}
 	return ast.WalkContinue, nil
 }
 
-func (r *Validator) visitListItem(_ util.BufWriter, _ []byte, _ ast.Node, entering bool) (ast.WalkStatus, error) {
+func (r *Validator) visitList(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering && len(r.items) > 0 && node.Parent().Kind() == ast.KindListItem {
+		// the text of a change description ends where its nested change descriptions start.
+		if parent := &r.items[len(r.items)-1]; !parent.hasText {
+			parent.text, parent.hasText = strings.TrimSpace(r.text.String()), true
+		}
+	}
+	return r.visitBlock(w, source, node, entering)
+}
+
+func (r *Validator) visitListItem(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !r.changelog.Change() {
+		if entering {
+			r.text.Reset()
+		}
+		return ast.WalkContinue, nil
+	}
 	if entering {
 		r.text.Reset()
+		r.items = append(r.items, pendingItem{position: r.positionOf(node), markdown: r.itemMarkdown(node)})
 		return ast.WalkContinue, nil
 	}
-	if r.changelog.Change() {
-		_, err := r.changelog.Section(changelog.ChangeDescription, r.text.String())
-		if err != nil {
-			return ast.WalkStop, err
+
+	pending := r.items[len(r.items)-1]
+	r.items = r.items[:len(r.items)-1]
+	if !pending.hasText {
+		pending.text = r.text.String()
+	}
+	item, err := r.headingsFactory.NewChangeItem(pending.text, pending.position, pending.markdown, pending.items)
+	if err != nil {
+		return r.abort(newError(rule.ChangeDescription, pending.position, err))
+	}
+	if len(r.items) > 0 {
+		parent := &r.items[len(r.items)-1]
+		parent.items = append(parent.items, item)
+		return ast.WalkContinue, nil
+	}
+
+	if err := r.changelog.Description(item); err != nil {
+		return r.abort(newError(rule.ChangeDescription, pending.position, err))
+	}
+	r.hasChangeDescriptions = true
+	if length := utf8.RuneCountInString(pending.text); length > maxDescriptionLength && r.fail(newError(rule.DescriptionLength, pending.position, fmt.Errorf("change description is %d characters long, more than %d", length, maxDescriptionLength))) {
+		return r.stop()
+	}
+	if err := r.validateUniqueDescription(pending.text, pending.position); err != nil && r.fail(err) {
+		return r.stop()
+	}
+	for _, err := range r.descriptionRules.Violations(pending.text) {
+		if r.fail(newError(rule.DescriptionFormat, pending.position, err)) {
+			return r.stop()
 		}
-		r.hasChangeDescriptions = true
 	}
 	return ast.WalkContinue, nil
 }
 
+// itemMarkdown returns the markdown of a change description as written in the source, the lines of its first block
+// without the list marker and without its nested change descriptions.
+func (r *Validator) itemMarkdown(node ast.Node) string {
+	child := node.FirstChild()
+	if child == nil || child.Type() != ast.TypeBlock {
+		return ""
+	}
+	var result strings.Builder
+	lines := child.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		result.Write(segment.Value(r.source))
+	}
+	return strings.TrimSpace(result.String())
+}
+
+// validateUniqueDescription validates that the change description does not repeat an earlier one
+// of the release, or of the changelog when checking across releases.
+func (r *Validator) validateUniqueDescription(description string, position changelog.Position) error {
+	key := normalizeDescription(description)
+	if first, ok := r.descriptions[key]; ok {
+		err := newError(rule.DescriptionDuplicate, position, fmt.Errorf("change description %q is a duplicate", description))
+		err.Related = Note{Position: first, Message: "first occurrence of the change description"}
+		return err
+	}
+	r.descriptions[key] = position
+	return nil
+}
+
+// normalizeDescription returns the description compared for duplicates, ignoring
+// the case, repeated whitespace and trailing punctuation.
+func normalizeDescription(description string) string {
+	return strings.ToLower(strings.TrimRight(strings.Join(strings.Fields(description), " "), " .,;:!?"))
+}
+
 func (r *Validator) visitImage(_ util.BufWriter, _ []byte, _ ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
 		return ast.WalkSkipChildren, nil
@@ -0,0 +1,269 @@
+package validator
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/denisa/clq/internal/changelog"
+	"github.com/denisa/clq/internal/config"
+	"github.com/denisa/clq/internal/rule"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark"
+	emoji "github.com/yuin/goldmark-emoji"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// withDocumentation is a change map with a build-level change kind.
+const withDocumentation = `[
+	{"name":"Added", "increment":"major"},
+	{"name":"Changed", "increment":"minor"},
+	{"name":"Fixed", "increment":"patch"},
+	{"name":"Documentation", "increment":"build"}
+]`
+
+func newChangeKind(t *testing.T, changeMap string) *changelog.ChangeKind {
+	if changeMap == "" {
+		ck, err := changelog.NewChangeKind("")
+		require.NoError(t, err)
+		return ck
+	}
+	fileName := filepath.Join(t.TempDir(), "changemap.json")
+	require.NoError(t, os.WriteFile(fileName, []byte(changeMap), 0o600))
+	ck, err := changelog.NewChangeKind(fileName)
+	require.NoError(t, err)
+	return ck
+}
+
+// validate validates the changelog, one heading or list item per line, and returns the violations of the rules.
+func validate(t *testing.T, changeKind *changelog.ChangeKind, lines []string, options ...config.Option) []Error {
+	source := []byte(strings.Join(lines, "\n") + "\n")
+	options = append([]config.Option{
+		config.WithChangeKind(changeKind),
+		config.WithToday(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)),
+	}, options...)
+	doc := goldmark.New(goldmark.WithExtensions(emoji.Emoji)).Parser().Parse(text.NewReader(source))
+	validationEngine := renderer.NewRenderer(
+		renderer.WithNodeRenderers(
+			util.Prioritized(NewValidator(config.NewConfig(options...)), 1000)))
+
+	err := validationEngine.Render(io.Discard, source, doc)
+	if err == nil {
+		return nil
+	}
+	var result []Error
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var e Error
+		require.True(t, errors.As(err, &e), err.Error())
+		result = append(result, e)
+	}
+	return result
+}
+
+// rulesOf returns the identifiers of the rules violated.
+func rulesOf(violations []Error) []string {
+	var result []string
+	for _, violation := range violations {
+		result = append(result, violation.Rule.ID)
+	}
+	return result
+}
+
+func TestValidatorPrereleasePrecedence(t *testing.T) {
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [1.2.0] - 2020-03-06",
+		"### Fixed",
+		"- fred",
+		"## [1.2.0-rc.2] - 2020-03-05",
+		"### Fixed",
+		"- waldo",
+		"## [1.2.0-rc.1] - 2020-03-04",
+		"### Changed",
+		"- gizmo",
+		"## [1.1.0] - 2020-03-01",
+		"### Changed",
+		"- baz",
+	})
+	require.Empty(t, violations)
+}
+
+func TestValidatorPrereleaseOutOfOrder(t *testing.T) {
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [1.2.0] - 2020-03-06",
+		"### Fixed",
+		"- fred",
+		"## [1.2.0-rc.1] - 2020-03-05",
+		"### Fixed",
+		"- waldo",
+		"## [1.2.0-rc.2] - 2020-03-04",
+		"### Changed",
+		"- gizmo",
+		"## [1.1.0] - 2020-03-01",
+		"### Changed",
+		"- baz",
+	})
+	require.Equal(t, []string{rule.VersionOrder.ID}, rulesOf(violations))
+	require.EqualError(t, violations[0].Err, `validation error: release "[1.2.0-rc.2] - 2020-03-04" should sort before "[1.2.0-rc.1] - 2020-03-05"`)
+}
+
+func TestValidatorPrereleaseRollUp(t *testing.T) {
+	// the Changed of 1.2.0-rc.1 justifies the minor increment of 1.2.0, not its Fixed.
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [1.2.0] - 2020-03-06",
+		"### Fixed",
+		"- fred",
+		"## [1.2.0-rc.1] - 2020-03-04",
+		"### Fixed",
+		"- gizmo",
+		"## [1.1.0] - 2020-03-01",
+		"### Changed",
+		"- baz",
+	})
+	require.Equal(t, []string{rule.VersionIncrement.ID}, rulesOf(violations))
+}
+
+func TestValidatorBuildMetadataIgnored(t *testing.T) {
+	violations := validate(t, newChangeKind(t, withDocumentation), []string{
+		"# Changelog",
+		"## [1.2.0+doc.1] - 2020-03-03",
+		"### Documentation",
+		"- foo",
+		"## [1.2.0+doc.2] - 2020-03-02",
+		"### Documentation",
+		"- bar",
+		"## [1.2.0] - 2020-03-01",
+		"### Changed",
+		"- baz",
+		"## [1.1.0] - 2020-02-01",
+		"### Changed",
+		"- qux",
+	})
+	require.Empty(t, violations)
+}
+
+func TestValidatorBuildMetadataNeedsBuildChanges(t *testing.T) {
+	violations := validate(t, newChangeKind(t, withDocumentation), []string{
+		"# Changelog",
+		"## [1.2.0+doc.1] - 2020-03-03",
+		"### Fixed",
+		"- foo",
+		"## [1.2.0] - 2020-03-01",
+		"### Changed",
+		"- baz",
+		"## [1.1.0] - 2020-02-01",
+		"### Changed",
+		"- qux",
+	})
+	require.Equal(t, []string{rule.BuildIncrement.ID}, rulesOf(violations))
+}
+
+func TestValidatorBuildMetadataDoesNotSortAfter(t *testing.T) {
+	violations := validate(t, newChangeKind(t, withDocumentation), []string{
+		"# Changelog",
+		"## [1.1.0+doc.9] - 2020-03-03",
+		"### Documentation",
+		"- foo",
+		"## [1.2.0] - 2020-03-01",
+		"### Changed",
+		"- baz",
+	})
+	require.Equal(t, []string{rule.VersionOrder.ID}, rulesOf(violations))
+}
+
+func TestValidatorAllErrors(t *testing.T) {
+	lines := []string{
+		"# Changelog",
+		"## [1.0.0] - 2020-03-01",
+		"### Fixed",
+		"- foo",
+		"## [1.0.0] - 2020-02-01",
+		"### Fixed",
+		"- bar",
+		"### Fixed",
+		"- baz",
+	}
+
+	require.Len(t, validate(t, newChangeKind(t, ""), lines), 1)
+	require.Equal(t, []string{rule.VersionOrder.ID, rule.ChangeHeadingDuplicate.ID},
+		rulesOf(validate(t, newChangeKind(t, ""), lines, config.WithAllErrors(true))))
+}
+
+func TestValidatorSeverity(t *testing.T) {
+	lines := []string{
+		"# Changelog",
+		"## [Unreleased]",
+		"## [1.0.0] - 2020-02-01",
+		"### Fixed",
+		"- bar",
+	}
+
+	violations := validate(t, newChangeKind(t, ""), lines)
+	require.Equal(t, []string{rule.UnreleasedEmpty.ID}, rulesOf(violations))
+	require.Equal(t, rule.Warning, violations[0].Severity)
+
+	violations = validate(t, newChangeKind(t, ""), lines, config.WithWarningsAsErrors(true))
+	require.Equal(t, rule.Error, violations[0].Severity)
+
+	require.Empty(t, validate(t, newChangeKind(t, ""), lines, config.WithRules(rule.Settings{rule.UnreleasedEmpty: rule.Off})))
+}
+
+func TestValidatorDuplicateDescriptions(t *testing.T) {
+	lines := []string{
+		"# Changelog",
+		"## [2.0.0] - 2020-03-01",
+		"### Added",
+		"- Same  thing",
+		"### Fixed",
+		"- same thing.",
+		"## [1.0.0] - 2020-02-01",
+		"### Added",
+		"- Same thing",
+	}
+
+	violations := validate(t, newChangeKind(t, ""), lines, config.WithAllErrors(true))
+	require.Equal(t, []string{rule.DescriptionDuplicate.ID}, rulesOf(violations))
+	require.Equal(t, changelog.Position{Line: 6, Column: 3}, violations[0].Position)
+	require.Equal(t, changelog.Position{Line: 4, Column: 3}, violations[0].Related.Position)
+
+	violations = validate(t, newChangeKind(t, ""), lines, config.WithAllErrors(true), config.WithDuplicatesAcrossReleases(true))
+	require.Equal(t, []string{rule.DescriptionDuplicate.ID, rule.DescriptionDuplicate.ID}, rulesOf(violations))
+	require.Equal(t, changelog.Position{Line: 9, Column: 3}, violations[1].Position)
+}
+
+func TestValidatorChangePolicyAfterEmptyUnreleased(t *testing.T) {
+	var policy config.ChangePolicy
+	require.NoError(t, policy.Forbid("Fixed"))
+
+	violations := validate(t, newChangeKind(t, ""), []string{
+		"# Changelog",
+		"## [Unreleased]",
+		"## [1.0.1] - 2020-03-01",
+		"### Fixed",
+		"- foo",
+		"## [1.0.0] - 2020-02-01",
+		"### Fixed",
+		"- bar",
+	}, config.WithAllErrors(true), config.WithRules(rule.Settings{rule.UnreleasedEmpty: rule.Off}), config.WithChangePolicy(policy))
+	require.Equal(t, []string{rule.ChangeForbidden.ID}, rulesOf(violations))
+	require.Equal(t, 4, violations[0].Position.Line)
+}
+
+func TestNormalizeDescription(t *testing.T) {
+	for description, expected := range map[string]string{
+		"Parse the headings":      "parse the headings",
+		"  Parse  the\theadings.": "parse the headings",
+		"Parse the headings!?":    "parse the headings",
+		"Parse v1.2":              "parse v1.2",
+	} {
+		require.Equal(t, expected, normalizeDescription(description), description)
+	}
+}